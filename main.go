@@ -1,14 +1,34 @@
 package main
 
 import (
+	"context"
 	"log/slog"
+	"os"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 
 	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
+const providerAddress = "registry.terraform.io/sysdiglabs/sysdig"
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := sysdig.GenerateConfig(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			slog.Default().Error("error generating the configuration", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := serve(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// serve serves the provider until Terraform stops it, the errors being logged. The clients are
+// closed before returning, so that the exit code can be set afterwards.
+func serve() error {
 	sysdigClient := sysdig.NewSysdigClients()
 	defer func() {
 		err := sysdigClient.Close()
@@ -17,6 +37,15 @@ func main() {
 		}
 	}()
 
-	provider := &sysdig.SysdigProvider{SysdigClient: sysdigClient}
-	plugin.Serve(&plugin.ServeOpts{ProviderFunc: provider.Provider})
+	serverFactory, err := sysdig.ProtoV5ProviderServerFactory(context.Background(), sysdigClient)
+	if err != nil {
+		slog.Default().Error("error creating the provider server", "error", err)
+		return err
+	}
+
+	err = tf5server.Serve(providerAddress, serverFactory)
+	if err != nil {
+		slog.Default().Error("error serving the provider", "error", err)
+	}
+	return err
 }
@@ -3,6 +3,7 @@ package sysdig
 const (
 	SchemaIDKey                         = "id"
 	SchemaTeamIDKey                     = "team_id"
+	SchemaTeamNameKey                   = "team_name"
 	SchemaPoliciesKey                   = "policies"
 	SchemaPolicyIDsKey                  = "policy_ids"
 	SchemaAuthorsKey                    = "authors"
@@ -14,6 +14,9 @@ const (
 	notificationChannelTypeTeamEmail              = "TEAM_EMAIL"
 	notificationChannelTypeCustomWebhook          = "POWER_WEBHOOK"
 	notificationChannelTypeIBMEventNotification   = "IBM_EVENT_NOTIFICATIONS"
+	notificationChannelTypeJira                   = "JIRA"
+	notificationChannelTypeServiceNow             = "SERVICE_NOW"
+	notificationChannelTypeWebex                  = "WEBEX"
 
 	notificationChannelTypeSlackTemplateKeyV1   = "SLACK_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v1"
 	notificationChannelTypeSlackTemplateKeyV2   = "SLACK_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"
@@ -1,11 +1,15 @@
 package sysdig_test
 
 import (
+	"context"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 const (
@@ -45,3 +49,17 @@ func sysdigOrIBMMonitorPreCheck(t *testing.T) func() {
 func randomText(len int) string {
 	return acctest.RandStringFromCharSet(len, acctest.CharSetAlphaNum)
 }
+
+// sysdigProviderFactories serves the muxed SDKv2 and plugin framework providers,
+// so test configurations can mix resources implemented on both sides.
+func sysdigProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"sysdig": func() (tfprotov5.ProviderServer, error) {
+			serverFactory, err := sysdig.ProtoV5ProviderServerFactory(context.Background(), sysdig.NewSysdigClients())
+			if err != nil {
+				return nil, err
+			}
+			return serverFactory(), nil
+		},
+	}
+}
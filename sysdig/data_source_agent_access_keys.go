@@ -2,10 +2,10 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 	"time"
 
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -69,9 +69,9 @@ func dataSourceSysdigAgentAccessKeyRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	agentKeyID := d.Get("id").(int)
-	agentAccessKey, statusCode, err := client.GetAgentAccessKeyByID(ctx, strconv.Itoa(agentKeyID))
+	agentAccessKey, err := client.GetAgentAccessKeyByID(ctx, strconv.Itoa(agentKeyID))
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -7,21 +7,15 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccAgentAccessKeyDataSource(t *testing.T) {
 	limit := 1
 	reservation := 0
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigSecureApiTokenEnv),
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigSecureApiTokenEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: getAgentAccessKey(limit, reservation, true),
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -6,19 +6,12 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 // Direct connection mode has been deprecated in Prod envs
 func TestAccSysdigFargateWorkloadAgentDirectConnection(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: getFargateWorkloadAgentDirectConnection(),
@@ -44,11 +37,7 @@ data "sysdig_fargate_workload_agent" "test" {
 
 func TestAccSysdigFargateWorkloadAgentOrchestrated(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: getFargateWorkloadAgentOrchestrated(),
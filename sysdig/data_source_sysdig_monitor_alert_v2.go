@@ -0,0 +1,193 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// alertV2Types maps the type names used by the sysdig_monitor_alert_v2_* resources to the API ones.
+var alertV2Types = map[string]v2.AlertV2Type{
+	"prometheus":            v2.AlertV2TypePrometheus,
+	"metric":                v2.AlertV2TypeManual,
+	"event":                 v2.AlertV2TypeEvent,
+	"downtime":              v2.AlertV2TypeDowntime,
+	"change":                v2.AlertV2TypeChange,
+	"group_outlier":         v2.AlertV2TypeGroupOutlier,
+	"anomaly":               v2.AlertV2TypeAnomaly,
+	"form_based_prometheus": v2.AlertV2TypeFormBasedPrometheus,
+}
+
+func dataSourceSysdigMonitorAlertV2() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	alertSchema := alertV2DataSourceSchema()
+	alertSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	for key, filter := range alertV2FilterSchema() {
+		// the filters also export the values of the alert found
+		filter.Computed = true
+		alertSchema[key] = filter
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorAlertV2Read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: alertSchema,
+	}
+}
+
+func dataSourceSysdigMonitorAlertV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	alerts, err := listAlertsV2(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch len(alerts) {
+	case 0:
+		return diag.Errorf("no alert found with name %q matching the filters", d.Get("name").(string))
+	case 1:
+	default:
+		return diag.Errorf("%d alerts found with name %q, use the type, enabled or labels filters to select one", len(alerts), d.Get("name").(string))
+	}
+
+	d.SetId(strconv.Itoa(alerts[0].ID))
+	for key, value := range alertV2ToMap(alerts[0]) {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// alertV2FilterSchema are the arguments both alert v2 data sources filter the alerts with.
+func alertV2FilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(slices.Sorted(maps.Keys(alertV2Types)), false),
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// alertV2DataSourceSchema are the attributes exported for every alert found.
+func alertV2DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"group": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"severity": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"team_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+// listAlertsV2 lists the alerts matching the name, type, enabled and labels arguments set in d.
+func listAlertsV2(ctx context.Context, d *schema.ResourceData, meta any) ([]v2.AlertV2Common, error) {
+	client, err := getAlertV2Client(meta.(SysdigClients))
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	alertType := alertV2Types[d.Get("type").(string)]
+	// false must be told apart from unset
+	enabled := d.GetRawConfig().GetAttr("enabled")
+	labels := d.Get("labels").(map[string]any)
+
+	var matching []v2.AlertV2Common
+	for _, alert := range alerts {
+		switch {
+		case name != "" && alert.Name != name:
+		case alertType != "" && alert.Type != string(alertType):
+		case !enabled.IsNull() && alert.Enabled != enabled.True():
+		case !alertV2HasLabels(alert, labels):
+		default:
+			matching = append(matching, alert)
+		}
+	}
+	return matching, nil
+}
+
+func alertV2HasLabels(alert v2.AlertV2Common, labels map[string]any) bool {
+	for key, value := range labels {
+		actual, ok := alert.Labels[key]
+		if !ok || fmt.Sprint(actual) != value.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+func alertV2ToMap(alert v2.AlertV2Common) map[string]any {
+	alertType := alert.Type
+	for name, t := range alertV2Types {
+		if string(t) == alert.Type {
+			alertType = name
+		}
+	}
+
+	labels := map[string]any{}
+	for key, value := range alert.Labels {
+		labels[key] = fmt.Sprint(value)
+	}
+
+	return map[string]any{
+		"id":          alert.ID,
+		"name":        alert.Name,
+		"description": alert.Description,
+		"type":        alertType,
+		"group":       alert.Group,
+		"severity":    alert.Severity,
+		"enabled":     alert.Enabled,
+		"team_id":     alert.TeamID,
+		"version":     alert.Version,
+		"labels":      labels,
+	}
+}
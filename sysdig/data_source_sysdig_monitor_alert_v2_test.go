@@ -0,0 +1,63 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSysdigMonitorAlertV2(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceAlertV2(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_alert_v2.by_name", "id", "sysdig_monitor_alert_v2_prometheus.sample", "id"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alert_v2.by_name", "type", "prometheus"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alert_v2.by_name", "severity", "high"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alert_v2.by_name", "labels.application", name),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alerts_v2.by_labels", "alerts.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_alerts_v2.by_labels", "ids.0", "sysdig_monitor_alert_v2_prometheus.sample", "id"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alerts_v2.enabled", "alerts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func dataSourceAlertV2(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_prometheus" "sample" {
+	name = "TERRAFORM TEST - PROMQL %[1]s"
+	severity = "high"
+	query = "up == 0"
+	duration_seconds = 300
+	enabled = false
+	labels = {
+		application = "%[1]s"
+	}
+}
+
+data "sysdig_monitor_alert_v2" "by_name" {
+	name = sysdig_monitor_alert_v2_prometheus.sample.name
+	type = "prometheus"
+}
+
+data "sysdig_monitor_alerts_v2" "by_labels" {
+	labels = sysdig_monitor_alert_v2_prometheus.sample.labels
+}
+
+data "sysdig_monitor_alerts_v2" "enabled" {
+	enabled = true
+	labels  = sysdig_monitor_alert_v2_prometheus.sample.labels
+}
+`, name)
+}
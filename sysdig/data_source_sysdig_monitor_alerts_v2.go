@@ -0,0 +1,90 @@
+package sysdig
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSysdigMonitorAlertsV2() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	alertSchema := alertV2DataSourceSchema()
+	maps.Copy(alertSchema, map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	})
+
+	dataSourceSchema := alertV2FilterSchema()
+	maps.Copy(dataSourceSchema, map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"alerts": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Resource{Schema: alertSchema},
+		},
+	})
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorAlertsV2Read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceSysdigMonitorAlertsV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	alerts, err := listAlertsV2(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := []int{}
+	result := []map[string]any{}
+	for _, alert := range alerts {
+		ids = append(ids, alert.ID)
+		result = append(result, alertV2ToMap(alert))
+	}
+
+	d.SetId("sysdig_monitor_alerts_v2")
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("alerts", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -3,7 +3,7 @@ package sysdig
 import (
 	"time"
 
-	"github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -0,0 +1,472 @@
+package sysdig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	legacyAlertTypeManual = "MANUAL"
+	legacyAlertTypeEvent  = "EVENT"
+)
+
+var (
+	// legacyMetricCondition matches the conditions of the legacy metric alerts, like avg(timeAvg(cpu.used.percent)) > 50
+	legacyMetricCondition = regexp.MustCompile(`^\s*(\w+)\(\s*(\w+)\(\s*([^()\s]+)\s*\)\s*\)\s*(>=|<=|!=|=|>|<)\s*(-?\d+(?:\.\d+)?)\s*$`)
+	// legacyEventCondition matches the conditions of the legacy event alerts, like count(customEvent) > 0
+	legacyEventCondition = regexp.MustCompile(`^\s*\w+\(\s*\w+\s*\)\s*(>=|<=|!=|=|>|<)\s*(-?\d+(?:\.\d+)?)\s*$`)
+	// prometheusMetricName matches the metric names alert v2 takes, like sysdig_container_cpu_used_percent
+	prometheusMetricName = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	// legacyScopeClause matches a clause of the scope of the legacy alerts, like kubernetes.cluster.name in ("a", "b")
+	legacyScopeClause = regexp.MustCompile(`(?i)^\s*([\w.\-/]+)\s+(=|!=|in|not in|contains|not contains|starts with)\s+(.+?)\s*$`)
+	legacyScopeValue  = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"|'((?:[^'\\]|\\.)*)'`)
+)
+
+var legacyScopeOperators = map[string]string{
+	"=":            "equals",
+	"!=":           "notEquals",
+	"in":           "in",
+	"not in":       "notIn",
+	"contains":     "contains",
+	"not contains": "notContains",
+	"starts with":  "startsWith",
+}
+
+func dataSourceSysdigMonitorLegacyAlert() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorLegacyAlertRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"range_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"operator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"metric": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_aggregation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_aggregation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"group_by": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notification_channels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"renotify_every_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"custom_notification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prepend": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"append": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getMonitorLegacyAlertClient(c SysdigClients) (v2.MonitorCommon, error) {
+	var client v2.MonitorCommon
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+func dataSourceSysdigMonitorLegacyAlertRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getMonitorLegacyAlertClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Get("id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alert, err := client.GetAlertByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var labelErr error
+	var guessedLabels []string
+	resourceType, values, err := legacyAlertToAlertV2(alert, func(label string) string {
+		publicID, err := client.GetLabelPublicID(ctx, label)
+		if errors.Is(err, v2.ErrLabelNotFound) {
+			// the public notation of most labels is their dot notation with underscores
+			publicID = strings.ReplaceAll(label, ".", "_")
+			if guess := fmt.Sprintf("%s as %s", label, publicID); !slices.Contains(guessedLabels, guess) {
+				guessedLabels = append(guessedLabels, guess)
+			}
+		} else if err != nil && labelErr == nil {
+			labelErr = err
+		}
+		return publicID
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if labelErr != nil {
+		return diag.FromErr(labelErr)
+	}
+
+	config, err := legacyAlertConfig(resourceType, alert, values)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(alert.ID))
+	_ = d.Set("type", alert.Type)
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("config", config)
+	for key, value := range values {
+		if key == "notification_channels" {
+			var channels []map[string]any
+			for _, channel := range value.([]any) {
+				channels = append(channels, map[string]any{
+					"id":                     channel.(map[string]any)["id"],
+					"renotify_every_minutes": channel.(map[string]any)["renotify_every_minutes"],
+				})
+			}
+			value = channels
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(guessedLabels) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Legacy alert %d references labels that are not known", alert.ID),
+			Detail:   "The public notation of these labels was guessed by replacing the dots with underscores, check them before applying the converted alert: " + strings.Join(guessedLabels, ", "),
+		}}
+	}
+	return nil
+}
+
+// legacyAlertToAlertV2 converts a legacy alert to the arguments of the equivalent alert v2 resource,
+// whose type is returned. publicLabel translates the labels in dot notation to the public notation.
+func legacyAlertToAlertV2(alert v2.Alert, publicLabel func(string) string) (resourceType string, values map[string]any, err error) {
+	group := alert.GroupName
+	if group == "" {
+		group = "default"
+	}
+
+	values = map[string]any{
+		"name":        alert.Name,
+		"description": alert.Description,
+		"enabled":     alert.Enabled,
+		"group":       group,
+		"severity":    legacyAlertSeverity(alert),
+	}
+
+	if alert.Timespan != nil {
+		values["range_seconds"] = *alert.Timespan / int(time.Second/time.Microsecond)
+	}
+
+	scope, err := legacyAlertScope(alert.Filter, publicLabel)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to convert the scope of alert %d: %w", alert.ID, err)
+	}
+	values["scope"] = scope
+
+	groupBy := []any{}
+	for _, segment := range alert.SegmentBy {
+		groupBy = append(groupBy, publicLabel(segment))
+	}
+	values["group_by"] = groupBy
+
+	channels := []any{}
+	for _, id := range alert.NotificationChannelIds {
+		renotifyEveryMinutes := 0
+		if alert.ReNotify {
+			renotifyEveryMinutes = alert.ReNotifyMinutes
+		}
+		channels = append(channels, map[string]any{
+			"id":                     id,
+			"renotify_every_minutes": renotifyEveryMinutes,
+			"notify_on_resolve":      true,
+			"main_threshold":         true,
+			"warning_threshold":      false,
+		})
+	}
+	values["notification_channels"] = channels
+
+	values["custom_notification"] = []any{}
+	if notification := alert.CustomNotification; notification != nil &&
+		(notification.TitleTemplate != "" || notification.PrependText != "" || notification.AppendText != "") {
+		values["custom_notification"] = []any{map[string]any{
+			"subject": notification.TitleTemplate,
+			"prepend": notification.PrependText,
+			"append":  notification.AppendText,
+		}}
+	}
+
+	switch alert.Type {
+	case legacyAlertTypeManual:
+		match := legacyMetricCondition.FindStringSubmatch(alert.Condition)
+		if match == nil {
+			return "", nil, fmt.Errorf("unable to convert the condition %q of alert %d", alert.Condition, alert.ID)
+		}
+		// the metrics in dot notation, like cpu.used.percent, have no single equivalent in the
+		// Prometheus notation, which depends on the entity: sysdig_host_cpu_used_percent,
+		// sysdig_container_cpu_used_percent...
+		if !prometheusMetricName.MatchString(match[3]) {
+			return "", nil, fmt.Errorf("unable to convert the metric %s of alert %d, the metrics in dot notation must be replaced by their Prometheus equivalent in the legacy alert first", match[3], alert.ID)
+		}
+		threshold, _ := strconv.ParseFloat(match[5], 64)
+		values["group_aggregation"] = match[1]
+		values["time_aggregation"] = match[2]
+		values["metric"] = match[3]
+		values["operator"] = match[4]
+		values["threshold"] = threshold
+		return "sysdig_monitor_alert_v2_metric", values, nil
+	case legacyAlertTypeEvent:
+		match := legacyEventCondition.FindStringSubmatch(alert.Condition)
+		if match == nil {
+			return "", nil, fmt.Errorf("unable to convert the condition %q of alert %d", alert.Condition, alert.ID)
+		}
+		threshold, _ := strconv.ParseFloat(match[2], 64)
+		values["operator"] = match[1]
+		values["threshold"] = threshold
+		values["filter"] = ""
+		values["sources"] = []any{}
+		if alert.Criteria != nil {
+			values["filter"] = alert.Criteria.Text
+			if alert.Criteria.Source != "" {
+				values["sources"] = []any{alert.Criteria.Source}
+			}
+		}
+		return "sysdig_monitor_alert_v2_event", values, nil
+	default:
+		return "", nil, fmt.Errorf("legacy alerts of type %s can't be converted to alert v2, only %s and %s ones can", alert.Type, legacyAlertTypeManual, legacyAlertTypeEvent)
+	}
+}
+
+func legacyAlertSeverity(alert v2.Alert) string {
+	switch {
+	case alert.Severity <= 1:
+		return string(v2.AlertV2SeverityHigh)
+	case alert.Severity <= 3:
+		return string(v2.AlertV2SeverityMedium)
+	case alert.Severity <= 5:
+		return string(v2.AlertV2SeverityLow)
+	default:
+		return string(v2.AlertV2SeverityInfo)
+	}
+}
+
+// legacyAlertScope converts the scope of a legacy alert, like kubernetes.namespace.name = "prod" and
+// kubernetes.cluster.name in ("a", "b"), to the scope blocks of the alert v2 resources.
+func legacyAlertScope(filter string, publicLabel func(string) string) ([]any, error) {
+	scope := []any{}
+	for _, clause := range splitLegacyScope(filter) {
+		match := legacyScopeClause.FindStringSubmatch(clause)
+		if match == nil {
+			return nil, fmt.Errorf("unsupported scope expression %q", clause)
+		}
+
+		values := []any{}
+		for _, value := range legacyScopeValue.FindAllStringSubmatch(match[3], -1) {
+			values = append(values, value[1]+value[2])
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("no value in scope expression %q", clause)
+		}
+
+		scope = append(scope, map[string]any{
+			"label":    publicLabel(match[1]),
+			"operator": legacyScopeOperators[strings.Join(strings.Fields(strings.ToLower(match[2])), " ")],
+			"values":   values,
+		})
+	}
+	return scope, nil
+}
+
+// splitLegacyScope splits the scope of a legacy alert in the clauses joined by "and", outside the quoted values.
+func splitLegacyScope(filter string) []string {
+	var clauses []string
+	var quote rune
+	start := 0
+	for i, r := range filter {
+		switch {
+		case quote != 0:
+			if r == quote && filter[i-1] != '\\' {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case (r == ' ' || r == '\t') && len(filter) > i+5 && strings.EqualFold(filter[i+1:i+5], "and "):
+			clauses = append(clauses, filter[start:i])
+			start = i + 5
+		}
+	}
+	if strings.TrimSpace(filter[start:]) != "" {
+		clauses = append(clauses, filter[start:])
+	}
+	return clauses
+}
+
+// legacyAlertConfig returns the configuration of the alert v2 resource equivalent to the legacy alert.
+func legacyAlertConfig(resourceType string, alert v2.Alert, values map[string]any) (string, error) {
+	var resource *schema.Resource
+	switch resourceType {
+	case "sysdig_monitor_alert_v2_metric":
+		resource = resourceSysdigMonitorAlertV2Metric()
+	default:
+		resource = resourceSysdigMonitorAlertV2Event()
+	}
+
+	d := resource.Data(nil)
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return "", err
+		}
+	}
+	// only the converted arguments are written, the others keep their default
+	resourceValues := map[string]any{}
+	for key := range values {
+		resourceValues[key] = d.Get(key)
+	}
+
+	generator := &configGenerator{file: hclwrite.NewEmptyFile(), labels: map[string]bool{}}
+	label := generator.label(resourceType, alert.Name, alert.ID)
+	generator.writeBody(generator.file.Body().AppendNewBlock("resource", []string{resourceType, label}).Body(), resource.Schema, resourceValues, "")
+	return string(generator.bytes()), nil
+}
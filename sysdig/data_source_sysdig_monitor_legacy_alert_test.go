@@ -0,0 +1,153 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func publicLabelForTest(label string) string {
+	return strings.ReplaceAll(label, ".", "_")
+}
+
+func TestLegacyAlertToAlertV2Metric(t *testing.T) {
+	timespan := 600000000
+	alert := v2.Alert{
+		ID:                     42,
+		Type:                   legacyAlertTypeManual,
+		Name:                   "High CPU",
+		Enabled:                true,
+		Severity:               2,
+		Timespan:               &timespan,
+		Condition:              "avg(timeAvg(sysdig_container_cpu_used_percent)) > 50.5",
+		Filter:                 `kubernetes.cluster.name in ("prod", "st and ing") and host.hostName != 'h1'`,
+		SegmentBy:              []string{"host.hostName"},
+		NotificationChannelIds: []int{7},
+		ReNotify:               true,
+		ReNotifyMinutes:        30,
+		CustomNotification:     &v2.CustomNotification{TitleTemplate: "{{__alert_name__}} is {{__alert_status__}}"},
+	}
+
+	resourceType, values, err := legacyAlertToAlertV2(alert, publicLabelForTest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resourceType != "sysdig_monitor_alert_v2_metric" {
+		t.Errorf("expected a metric alert, got %s", resourceType)
+	}
+	for key, expected := range map[string]any{
+		"group":             "default",
+		"severity":          "medium",
+		"range_seconds":     600,
+		"group_aggregation": "avg",
+		"time_aggregation":  "timeAvg",
+		"metric":            "sysdig_container_cpu_used_percent",
+		"operator":          ">",
+		"threshold":         50.5,
+		"group_by":          []any{"host_hostName"},
+		"scope": []any{
+			map[string]any{"label": "kubernetes_cluster_name", "operator": "in", "values": []any{"prod", "st and ing"}},
+			map[string]any{"label": "host_hostName", "operator": "notEquals", "values": []any{"h1"}},
+		},
+	} {
+		if !reflect.DeepEqual(values[key], expected) {
+			t.Errorf("expected %s to be %v, got %v", key, expected, values[key])
+		}
+	}
+	channel := values["notification_channels"].([]any)[0].(map[string]any)
+	if channel["id"] != 7 || channel["renotify_every_minutes"] != 30 {
+		t.Errorf("unexpected notification channel %v", channel)
+	}
+
+	config, err := legacyAlertConfig(resourceType, alert, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{
+		`resource "sysdig_monitor_alert_v2_metric" "high_cpu" {`,
+		`metric`,
+		`"sysdig_container_cpu_used_percent"`,
+		`"kubernetes_cluster_name"`,
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected the configuration to contain %s, got:\n%s", expected, config)
+		}
+	}
+}
+
+func TestLegacyAlertToAlertV2Event(t *testing.T) {
+	alert := v2.Alert{
+		ID:        43,
+		Type:      legacyAlertTypeEvent,
+		Name:      "Pod restarts",
+		Severity:  6,
+		Condition: "count(customEvent) >= 3",
+		Criteria:  &v2.Criteria{Text: "restart", Source: "kubernetes"},
+	}
+
+	resourceType, values, err := legacyAlertToAlertV2(alert, publicLabelForTest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resourceType != "sysdig_monitor_alert_v2_event" {
+		t.Errorf("expected an event alert, got %s", resourceType)
+	}
+	for key, expected := range map[string]any{
+		"severity":  "info",
+		"operator":  ">=",
+		"threshold": 3.0,
+		"filter":    "restart",
+		"sources":   []any{"kubernetes"},
+		"scope":     []any{},
+	} {
+		if !reflect.DeepEqual(values[key], expected) {
+			t.Errorf("expected %s to be %v, got %v", key, expected, values[key])
+		}
+	}
+}
+
+func TestLegacyAlertToAlertV2ZeroThreshold(t *testing.T) {
+	alert := v2.Alert{
+		ID:        44,
+		Type:      legacyAlertTypeEvent,
+		Name:      "Any event",
+		Condition: "count(customEvent) > 0",
+	}
+
+	resourceType, values, err := legacyAlertToAlertV2(alert, publicLabelForTest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config, err := legacyAlertConfig(resourceType, alert, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, diags := hclsyntax.ParseConfig([]byte(config), "config.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("invalid configuration: %s\n%s", diags, config)
+	}
+	for _, expected := range []string{`operator`, `">"`, `threshold = 0`} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected the configuration to contain %s, got:\n%s", expected, config)
+		}
+	}
+}
+
+func TestLegacyAlertToAlertV2Unsupported(t *testing.T) {
+	for _, alert := range []v2.Alert{
+		{ID: 1, Type: "BASELINE", Condition: "avg(timeAvg(cpu.used.percent)) > 50"},
+		{ID: 2, Type: legacyAlertTypeManual, Condition: "avg(timeAvg(cpu.used.percent)) > 50 or avg(timeAvg(memory.used.percent)) > 50"},
+		{ID: 3, Type: legacyAlertTypeManual, Condition: "avg(timeAvg(sysdig_host_cpu_used_percent)) > 50", Filter: "host.hostName exists"},
+		{ID: 4, Type: legacyAlertTypeManual, Condition: "avg(timeAvg(cpu.used.percent)) > 50"},
+	} {
+		if _, _, err := legacyAlertToAlertV2(alert, publicLabelForTest); err == nil {
+			t.Errorf("expected alert %d not to be converted", alert.ID)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package sysdig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func createMonitorNotificationChannelSchema(original map[string]*schema.Schema) map[string]*schema.Schema {
+	notificationChannelSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"share_with_current_team": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"notify_when_ok": {
+			Type:       schema.TypeBool,
+			Optional:   true,
+			Default:    false,
+			Deprecated: "The notify_when_ok field is deprecated and will be removed in a future version. This flag has been replaced by the `notify_on_resolve` field inside `notification_channels` when defining an alert resource.",
+		},
+		"notify_when_resolved": {
+			Type:       schema.TypeBool,
+			Optional:   true,
+			Default:    false,
+			Deprecated: "The notify_when_resolved field is deprecated and will be removed in a future version. This flag has been replaced by the `notify_on_acknowledge` field inside `notification_channels` when defining an alert resource.",
+		},
+		"version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"send_test_notification": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+
+	maps.Copy(notificationChannelSchema, original)
+
+	return notificationChannelSchema
+}
+
+// monitorNotificationChannelDataSource returns the data source of a channel type, which reads
+// the channel through the model of the framework resource of the type, so that both convert the
+// API options the same way. The attributes are the ones specific to the type.
+func monitorNotificationChannelDataSource(newResource func() resource.Resource, attributes map[string]*schema.Schema) *schema.Resource {
+	timeout := 5 * time.Minute
+	dataSourceSchema := createMonitorNotificationChannelSchema(attributes)
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			nc, err := client.GetNotificationChannelByName(ctx, d.Get("name").(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			r := newResource().(*monitorNotificationChannelResource)
+			model := r.newModel()
+			if err := frameworkDiagnosticsError(r.notificationChannelToModel(ctx, &nc, model)); err != nil {
+				return diag.FromErr(err)
+			}
+			// "send_test_notification" is not persisted by the API, it keeps its default
+			if err := modelToResourceData(ctx, model, dataSourceSchema, d, "send_test_notification"); err != nil {
+				return diag.FromErr(err)
+			}
+
+			d.SetId(strconv.Itoa(nc.ID))
+
+			return nil
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: dataSourceSchema,
+	}
+}
+
+// modelToResourceData sets the attributes of the SDK schema from the fields of a framework
+// model with the same tfsdk tags, including the ones of its embedded models. The fields which
+// are not in the schema, or are null, are left as they are.
+func modelToResourceData(ctx context.Context, model any, s map[string]*schema.Schema, d *schema.ResourceData, skip ...string) error {
+	return structToResourceData(ctx, reflect.Indirect(reflect.ValueOf(model)), s, d, skip)
+}
+
+func structToResourceData(ctx context.Context, value reflect.Value, s map[string]*schema.Schema, d *schema.ResourceData, skip []string) error {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if field.Anonymous {
+			if err := structToResourceData(ctx, value.Field(i), s, d, skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := field.Tag.Get("tfsdk")
+		if _, ok := s[name]; !ok || slices.Contains(skip, name) {
+			continue
+		}
+		attribute, ok := value.Field(i).Interface().(attr.Value)
+		if !ok || attribute.IsNull() || attribute.IsUnknown() {
+			continue
+		}
+
+		var v any
+		var diags fwdiag.Diagnostics
+		switch a := attribute.(type) {
+		case types.String:
+			v = a.ValueString()
+		case types.Bool:
+			v = a.ValueBool()
+		case types.Int64:
+			v = int(a.ValueInt64())
+		case types.Set:
+			var elements []string
+			diags = a.ElementsAs(ctx, &elements, false)
+			v = elements
+		case types.List:
+			var elements []string
+			diags = a.ElementsAs(ctx, &elements, false)
+			v = elements
+		case types.Map:
+			var elements map[string]string
+			diags = a.ElementsAs(ctx, &elements, false)
+			v = elements
+		default:
+			return fmt.Errorf("unsupported type %T of the attribute %s", attribute, name)
+		}
+		if err := frameworkDiagnosticsError(diags); err != nil {
+			return err
+		}
+		if err := d.Set(name, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// frameworkDiagnosticsError returns the errors of framework diagnostics as a single error.
+func frameworkDiagnosticsError(diags fwdiag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
+
+func getMonitorNotificationChannelClient(c SysdigClients) (v2.NotificationChannelInterface, error) {
+	var client v2.NotificationChannelInterface
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
@@ -1,66 +1,28 @@
 package sysdig
 
-import (
-	"context"
-	"strconv"
-	"time"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-)
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceSysdigMonitorNotificationChannelCustomWebhook() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		ReadContext: dataSourceSysdigMonitorNotificationChannelCustomWebhookRead,
-
-		Timeouts: &schema.ResourceTimeout{
-			Read: schema.DefaultTimeout(timeout),
+	return monitorNotificationChannelDataSource(newMonitorNotificationChannelCustomWebhookResource, map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
 		},
-
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"url": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"http_method": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"template": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"allow_insecure_connections": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"additional_headers": {
-				Type:     schema.TypeMap,
-				Computed: true,
-			},
-		}),
-	}
-}
-
-func dataSourceSysdigMonitorNotificationChannelCustomWebhookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := client.GetNotificationChannelByName(ctx, d.Get("name").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelCustomWebhookToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(nc.ID))
-
-	return nil
+		"http_method": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"allow_insecure_connections": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"additional_headers": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	})
 }
@@ -0,0 +1,38 @@
+package sysdig
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func dataSourceSysdigMonitorNotificationChannelJira() *schema.Resource {
+	return monitorNotificationChannelDataSource(newMonitorNotificationChannelJiraResource, map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"project": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"issue_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"user": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"api_token": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"assignee": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"labels": {
+			Type:     schema.TypeSet,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+	})
+}
@@ -0,0 +1,21 @@
+package sysdig
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func dataSourceSysdigMonitorNotificationChannelServiceNow() *schema.Resource {
+	return monitorNotificationChannelDataSource(newMonitorNotificationChannelServiceNowResource, map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	})
+}
@@ -0,0 +1,100 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestMonitorNotificationChannelDataSources(t *testing.T) {
+	ctx := context.Background()
+	provider, client := newMockAPIMonitorProvider(t)
+
+	allowInsecureConnections := true
+	for _, channel := range []v2.NotificationChannel{
+		{
+			Type:    notificationChannelTypeSlack,
+			Name:    "slack",
+			Enabled: true,
+			Options: v2.NotificationChannelOptions{
+				URL:     "https://hooks.slack.com/services/on-call",
+				Channel: "#on-call",
+				TemplateConfiguration: []v2.NotificationChannelTemplateConfiguration{{
+					TemplateKey: monitorSlackTemplateKey,
+					TemplateConfigurationSections: []v2.NotificationChannelTemplateConfigurationSection{
+						{SectionName: "MONITOR_ALERT_NOTIFICATION_CHART", ShouldShow: false},
+					},
+				}},
+			},
+		},
+		{
+			Type:    notificationChannelTypeWebhook,
+			Name:    "webhook",
+			Enabled: false,
+			Options: v2.NotificationChannelOptions{
+				URL:                      "https://hooks.example.com/on-call",
+				AllowInsecureConnections: &allowInsecureConnections,
+				AdditionalHeaders:        map[string]any{"X-Team": "payments"},
+			},
+		},
+		{
+			Type:    notificationChannelTypeTeamEmail,
+			Name:    "team email",
+			Enabled: true,
+			Options: v2.NotificationChannelOptions{TeamID: 7, NotifyOnResolve: true},
+		},
+	} {
+		if _, err := client.CreateNotificationChannel(ctx, channel); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		dataSource *schema.Resource
+		name       string
+		expected   map[string]any
+	}{
+		{dataSourceSysdigMonitorNotificationChannelSlack(), "slack", map[string]any{
+			"enabled":                         true,
+			"channel":                         "#on-call",
+			"show_section_notification_chart": false,
+			"show_section_runbook_links":      true,
+		}},
+		{dataSourceSysdigMonitorNotificationChannelWebhook(), "webhook", map[string]any{
+			"enabled":                    false,
+			"url":                        "https://hooks.example.com/on-call",
+			"allow_insecure_connections": true,
+			"additional_headers.X-Team":  "payments",
+			"send_test_notification":     false,
+			"share_with_current_team":    false,
+		}},
+		{dataSourceSysdigMonitorNotificationChannelTeamEmail(), "team email", map[string]any{
+			"team_id":              7,
+			"include_admin_users":  false,
+			"notify_when_resolved": true,
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.dataSource.TestResourceData()
+			if err := d.Set("name", tc.name); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diags := tc.dataSource.ReadContext(ctx, d, provider.Meta()); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() == "" {
+				t.Error("expected the ID to be set")
+			}
+			for key, value := range tc.expected {
+				if actual := d.Get(key); actual != value {
+					t.Errorf("expected %s to be %v, got %v", key, value, actual)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package sysdig
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func dataSourceSysdigMonitorNotificationChannelWebex() *schema.Resource {
+	return monitorNotificationChannelDataSource(newMonitorNotificationChannelWebexResource, map[string]*schema.Schema{
+		"room_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"access_token": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	})
+}
@@ -0,0 +1,44 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMonitorNotificationChannelWebexDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelWebex(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_webex.nc_webex", "name", "sysdig_monitor_notification_channel_webex.nc_webex", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_webex.nc_webex", "room_id", "sysdig_monitor_notification_channel_webex.nc_webex", "room_id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_webex.nc_webex", "access_token", "sysdig_monitor_notification_channel_webex.nc_webex", "access_token"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelWebex(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webex" "nc_webex" {
+	name = "%s"
+	room_id = "Y2lzY29zcGFyazovL3VzL1JPT00v"
+	access_token = "NjM0ZTUwZDAtYzRi"
+}
+
+data "sysdig_monitor_notification_channel_webex" "nc_webex" {
+	name = sysdig_monitor_notification_channel_webex.nc_webex.name
+}
+`, name)
+}
@@ -2,9 +2,9 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -121,9 +121,9 @@ func dataSourceSysdigMonitorTeamRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	team, statusCode, err := client.GetTeamByID(ctx, id)
+	team, err := client.GetTeamByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
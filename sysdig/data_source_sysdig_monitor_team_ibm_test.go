@@ -7,20 +7,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 func TestAccDataSourceSysdigMonitorTeamIBM(t *testing.T) {
 	name := fmt.Sprintf("test-monitor-team-%s", randomText(5))
 	resource.Test(t, resource.TestCase{
-		PreCheck: preCheckAnyEnv(t, SysdigIBMMonitorAPIKeyEnv),
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		PreCheck:                 preCheckAnyEnv(t, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: monitorTeamWithPlatformMetricsAndDatasourceIBM(name),
@@ -0,0 +1,187 @@
+package sysdig
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// notificationChannelTypeNames maps the type names used by the blocks of sysdig_notification_channel
+// to the API ones.
+func notificationChannelTypeNames() map[string]string {
+	names := map[string]string{}
+	for _, t := range notificationChannelTypes() {
+		names[t.typeName] = t.channelType
+	}
+	return names
+}
+
+func dataSourceSysdigNotificationChannels() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigNotificationChannelsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "monitor",
+				ValidateFunc: validation.StringInSlice([]string{"monitor", "secure"}, false),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(slices.Sorted(maps.Keys(notificationChannelTypeNames())), false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"team_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"notification_channels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"team_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSysdigNotificationChannelsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	channels, err := listNotificationChannels(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := []int{}
+	result := []map[string]any{}
+	for _, channel := range channels {
+		ids = append(ids, channel.ID)
+		result = append(result, notificationChannelToMap(channel))
+	}
+
+	d.SetId("sysdig_notification_channels")
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("notification_channels", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// listNotificationChannels lists the channels of the product matching the type, name_regex,
+// enabled and team_id arguments set in d, ordered by ID. The team_id filter keeps the channels
+// shared with all teams.
+func listNotificationChannels(ctx context.Context, d *schema.ResourceData, meta any) ([]v2.NotificationChannel, error) {
+	getClient := getMonitorNotificationChannelClient
+	if d.Get("product").(string) == "secure" {
+		getClient = getSecureNotificationChannelClient
+	}
+	client, err := getClient(meta.(SysdigClients))
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := client.ListNotificationChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channelType := notificationChannelTypeNames()[d.Get("type").(string)]
+	// validated by the schema
+	nameRegex := regexp.MustCompile(d.Get("name_regex").(string))
+	// false and 0 must be told apart from unset
+	enabled := d.GetRawConfig().GetAttr("enabled")
+	teamID := d.GetRawConfig().GetAttr("team_id")
+
+	var matching []v2.NotificationChannel
+	for _, channel := range channels {
+		switch {
+		case channelType != "" && channel.Type != channelType:
+		case !nameRegex.MatchString(channel.Name):
+		case !enabled.IsNull() && channel.Enabled != enabled.True():
+		// the channels shared with all teams, without a team, are available to the team too
+		case !teamID.IsNull() && channel.TeamID != nil && *channel.TeamID != d.Get("team_id").(int):
+		default:
+			matching = append(matching, channel)
+		}
+	}
+	slices.SortFunc(matching, func(a, b v2.NotificationChannel) int { return a.ID - b.ID })
+	return matching, nil
+}
+
+func notificationChannelToMap(channel v2.NotificationChannel) map[string]any {
+	channelType := channel.Type
+	for name, t := range notificationChannelTypeNames() {
+		if t == channel.Type {
+			channelType = name
+		}
+	}
+
+	teamID := 0
+	if channel.TeamID != nil {
+		teamID = *channel.TeamID
+	}
+
+	return map[string]any{
+		"id":      channel.ID,
+		"name":    channel.Name,
+		"type":    channelType,
+		"enabled": channel.Enabled,
+		"team_id": teamID,
+		"version": channel.Version,
+	}
+}
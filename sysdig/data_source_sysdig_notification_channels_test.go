@@ -0,0 +1,67 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSysdigNotificationChannels(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceNotificationChannels(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sysdig_notification_channels.by_name", "notification_channels.#", "2"),
+					resource.TestCheckResourceAttr("data.sysdig_notification_channels.enabled_email", "notification_channels.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sysdig_notification_channels.enabled_email", "ids.0", "sysdig_monitor_notification_channel_email.enabled", "id"),
+					resource.TestCheckResourceAttr("data.sysdig_notification_channels.enabled_email", "notification_channels.0.type", "email"),
+					resource.TestCheckResourceAttr("data.sysdig_notification_channels.disabled", "notification_channels.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sysdig_notification_channels.disabled", "ids.0", "sysdig_monitor_notification_channel_slack.disabled", "id"),
+				),
+			},
+		},
+	})
+}
+
+func dataSourceNotificationChannels(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "enabled" {
+	name       = "Example Channel %[1]s - Email"
+	recipients = ["foo@localhost.com"]
+}
+
+resource "sysdig_monitor_notification_channel_slack" "disabled" {
+	name    = "Example Channel %[1]s - Slack"
+	enabled = false
+	url     = "https://hooks.slack.com/services/XXXXXXXXX/XXXXXXXXX/XXXXXXXXXXXXXXXXXXXXXXXX"
+	channel = "#sysdig"
+}
+
+data "sysdig_notification_channels" "by_name" {
+	name_regex = "^Example Channel %[1]s - "
+	depends_on = [sysdig_monitor_notification_channel_email.enabled, sysdig_monitor_notification_channel_slack.disabled]
+}
+
+data "sysdig_notification_channels" "enabled_email" {
+	type       = "email"
+	enabled    = true
+	name_regex = "^Example Channel %[1]s - "
+	depends_on = [sysdig_monitor_notification_channel_email.enabled, sysdig_monitor_notification_channel_slack.disabled]
+}
+
+data "sysdig_notification_channels" "disabled" {
+	enabled    = false
+	name_regex = "^Example Channel %[1]s - "
+	depends_on = [sysdig_monitor_notification_channel_email.enabled, sysdig_monitor_notification_channel_slack.disabled]
+}
+`, name)
+}
@@ -0,0 +1,94 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestNotificationChannels(t *testing.T) {
+	ctx := context.Background()
+	provider, client := newMockAPIMonitorProvider(t)
+
+	payments := 7
+	ids := map[string]int{}
+	for _, channel := range []v2.NotificationChannel{
+		{Type: notificationChannelTypePagerduty, Name: "payments on call", Enabled: true, TeamID: &payments},
+		{Type: notificationChannelTypePagerduty, Name: "payments escalation", Enabled: false, TeamID: &payments},
+		{Type: notificationChannelTypePagerduty, Name: "search on call", Enabled: true},
+		{Type: notificationChannelTypeEmail, Name: "payments audit", Enabled: true, TeamID: &payments},
+	} {
+		created, err := client.CreateNotificationChannel(ctx, channel)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids[channel.Name] = created.ID
+	}
+
+	dataSource := dataSourceSysdigNotificationChannels()
+	// the raw configuration tells unset filters apart from false and 0
+	data := func(config map[string]any) *schema.ResourceData {
+		rawConfig := map[string]cty.Value{"enabled": cty.NullVal(cty.Bool), "team_id": cty.NullVal(cty.Number)}
+		if enabled, ok := config["enabled"]; ok {
+			rawConfig["enabled"] = cty.BoolVal(enabled.(bool))
+		}
+		if teamID, ok := config["team_id"]; ok {
+			rawConfig["team_id"] = cty.NumberIntVal(int64(teamID.(int)))
+		}
+		d := dataSource.Data(&terraform.InstanceState{RawConfig: cty.ObjectVal(rawConfig)})
+		for key, value := range config {
+			if err := d.Set(key, value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		return d
+	}
+	for _, tc := range []struct {
+		name     string
+		config   map[string]any
+		expected []string
+	}{
+		{"no filters", map[string]any{}, []string{"payments on call", "payments escalation", "search on call", "payments audit"}},
+		{"type", map[string]any{"type": "pagerduty"}, []string{"payments on call", "payments escalation", "search on call"}},
+		{"name regex", map[string]any{"name_regex": "^payments"}, []string{"payments on call", "payments escalation", "payments audit"}},
+		{"disabled", map[string]any{"enabled": false}, []string{"payments escalation"}},
+		{"enabled pagerduty of a team", map[string]any{"type": "pagerduty", "enabled": true, "team_id": payments}, []string{"payments on call", "search on call"}},
+		{"other team", map[string]any{"team_id": 8}, []string{"search on call"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := data(tc.config)
+			if diags := dataSource.ReadContext(ctx, d, provider.Meta()); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			expected := []int{}
+			for _, name := range tc.expected {
+				expected = append(expected, ids[name])
+			}
+			slices.Sort(expected)
+			var actual []int
+			for _, id := range d.Get("ids").([]any) {
+				actual = append(actual, id.(int))
+			}
+			if !slices.Equal(actual, expected) {
+				t.Errorf("expected the channels %v, got %v", expected, actual)
+			}
+		})
+	}
+
+	d := data(map[string]any{"name_regex": "^payments on call$"})
+	if diags := dataSource.ReadContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Get("notification_channels.0.type") != "pagerduty" || d.Get("notification_channels.0.team_id") != payments || d.Get("notification_channels.0.enabled") != true {
+		t.Errorf("unexpected channel %v", d.Get("notification_channels"))
+	}
+}
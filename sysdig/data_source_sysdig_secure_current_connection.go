@@ -34,7 +34,7 @@ func dataSourceSecureConnectionRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	apiToken, err := meta.(SysdigClients).GetSecureAPIToken()
+	apiToken, err := meta.(SysdigClients).GetSecureAPIToken(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
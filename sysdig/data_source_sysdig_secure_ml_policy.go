@@ -82,7 +82,7 @@ func compositePolicyDataSourceRead(ctx context.Context, d *schema.ResourceData,
 
 	policyName := d.Get("name").(string)
 
-	policies, _, err := client.ListCompositePoliciesByNameAndType(ctx, policyType, policyName)
+	policies, err := client.ListCompositePoliciesByNameAndType(ctx, policyType, policyName)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,51 @@
+//go:build tf_acc_sysdig_secure || tf_acc_ibm_secure || tf_acc_onprem_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecureNotificationChannelJiraDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelJira(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "name", "sysdig_secure_notification_channel_jira.nc_jira", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "url", "sysdig_secure_notification_channel_jira.nc_jira", "url"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "project", "sysdig_secure_notification_channel_jira.nc_jira", "project"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "issue_type", "sysdig_secure_notification_channel_jira.nc_jira", "issue_type"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "user", "sysdig_secure_notification_channel_jira.nc_jira", "user"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "api_token", "sysdig_secure_notification_channel_jira.nc_jira", "api_token"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_jira.nc_jira", "labels.#", "sysdig_secure_notification_channel_jira.nc_jira", "labels.#"),
+				),
+			},
+		},
+	})
+}
+
+func secureNotificationChannelJira(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_jira" "nc_jira" {
+	name = "%s"
+	url = "https://example.atlassian.net"
+	project = "OPS"
+	user = "jira@example.com"
+	api_token = "ATATT3xFfGF0"
+	labels = ["sysdig"]
+}
+
+data "sysdig_secure_notification_channel_jira" "nc_jira" {
+	name = sysdig_secure_notification_channel_jira.nc_jira.name
+}
+`, name)
+}
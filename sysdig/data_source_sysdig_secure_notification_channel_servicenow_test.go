@@ -0,0 +1,46 @@
+//go:build tf_acc_sysdig_secure || tf_acc_ibm_secure || tf_acc_onprem_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecureNotificationChannelServiceNowDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelServiceNow(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "name", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "url", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "url"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "username", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "username"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "password", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "password"),
+				),
+			},
+		},
+	})
+}
+
+func secureNotificationChannelServiceNow(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_servicenow" "nc_servicenow" {
+	name = "%s"
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "changeme"
+}
+
+data "sysdig_secure_notification_channel_servicenow" "nc_servicenow" {
+	name = sysdig_secure_notification_channel_servicenow.nc_servicenow.name
+}
+`, name)
+}
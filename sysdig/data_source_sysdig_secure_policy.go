@@ -186,7 +186,7 @@ func commonDataSourceSecurePolicyRead(ctx context.Context, d *schema.ResourceDat
 	policyName := d.Get("name").(string)
 	policyType := d.Get("type").(string)
 
-	policies, _, err := client.GetPolicies(ctx)
+	policies, err := client.GetPolicies(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -206,7 +206,7 @@ func commonDataSourceSecurePolicyRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("unable to find %s", resourceName)
 	}
 
-	loadedPolicy, _, err := client.GetPolicyByID(ctx, policy.ID)
+	loadedPolicy, err := client.GetPolicyByID(ctx, policy.ID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
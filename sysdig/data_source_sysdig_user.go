@@ -2,10 +2,10 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 	"time"
 
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,9 +51,9 @@ func dataSourceSysdigUserRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	u, statusCode, err := client.GetUserByEmail(ctx, d.Get("email").(string))
+	u, err := client.GetUserByEmail(ctx, d.Get("email").(string))
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
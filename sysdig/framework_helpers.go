@@ -0,0 +1,84 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sysdigClientsFromProviderData extracts the shared SysdigClients handed over by
+// SysdigFrameworkProvider.Configure. It returns nil while the provider is not yet configured.
+func sysdigClientsFromProviderData(providerData any, diags *diag.Diagnostics) SysdigClients {
+	if providerData == nil {
+		return nil
+	}
+
+	clients, ok := providerData.(SysdigClients)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected SysdigClients, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+	return clients
+}
+
+func configureFrameworkResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) SysdigClients {
+	return sysdigClientsFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// optionalString keeps a null value in state when the API answers with an empty string,
+// so that unset optional attributes don't produce a perpetual diff.
+func optionalString(value string, prior types.String) types.String {
+	if value == "" && prior.IsNull() {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// optionalStringMap is the map counterpart of optionalString.
+func optionalStringMap(ctx context.Context, value map[string]any, prior types.Map) (types.Map, diag.Diagnostics) {
+	if len(value) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+
+	elements := make(map[string]string, len(value))
+	for k, v := range value {
+		elements[k] = fmt.Sprint(v)
+	}
+	return types.MapValueFrom(ctx, types.StringType, elements)
+}
+
+func stringMapFromModel(ctx context.Context, value types.Map) (map[string]any, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var elements map[string]string
+	diags := value.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make(map[string]any, len(elements))
+	for k, v := range elements {
+		result[k] = v
+	}
+	return result, diags
+}
+
+// optionalBoolPointer maps a boolean the API may omit, keeping the prior value
+// (or def when there is none) if it is missing from the response.
+func optionalBoolPointer(value *bool, prior types.Bool, def bool) types.Bool {
+	if value != nil {
+		return types.BoolValue(*value)
+	}
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.BoolValue(def)
+	}
+	return prior
+}
@@ -0,0 +1,382 @@
+package sysdig
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// notificationChannelDataSources are the data sources the notification channels referenced
+// by the generated alerts are looked up with, by channel type.
+var notificationChannelDataSources = map[string]string{
+	notificationChannelTypeEmail:                  "sysdig_monitor_notification_channel_email",
+	notificationChannelTypeAmazonSNS:              "sysdig_monitor_notification_channel_sns",
+	notificationChannelTypeOpsGenie:               "sysdig_monitor_notification_channel_opsgenie",
+	notificationChannelTypeVictorOps:              "sysdig_monitor_notification_channel_victorops",
+	notificationChannelTypeWebhook:                "sysdig_monitor_notification_channel_webhook",
+	notificationChannelTypeSlack:                  "sysdig_monitor_notification_channel_slack",
+	notificationChannelTypePagerduty:              "sysdig_monitor_notification_channel_pagerduty",
+	notificationChannelTypeMSTeams:                "sysdig_monitor_notification_channel_msteams",
+	notificationChannelTypeGChat:                  "sysdig_monitor_notification_channel_google_chat",
+	notificationChannelTypePrometheusAlertManager: "sysdig_monitor_notification_channel_prometheus_alert_manager",
+	notificationChannelTypeTeamEmail:              "sysdig_monitor_notification_channel_team_email",
+	notificationChannelTypeCustomWebhook:          "sysdig_monitor_notification_channel_custom_webhook",
+	notificationChannelTypeIBMEventNotification:   "sysdig_monitor_notification_channel_ibm_event_notification",
+}
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// GenerateConfig implements the "generate" command of the provider binary. It reads every
+// Monitor alert and dashboard of the team the provider is configured for, through the same
+// SYSDIG_* environment variables, and writes the import blocks and the configuration which
+// bring them under Terraform management.
+func GenerateConfig(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	output := flags.String("out", "", "file the configuration is written to, instead of the standard output")
+	alerts := flags.Bool("alerts", true, "generate the configuration of the alerts")
+	dashboards := flags.Bool("dashboards", true, "generate the configuration of the dashboards")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	provider := Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]any{})); diags.HasError() {
+		return diagnosticsError(diags)
+	}
+
+	generator := &configGenerator{
+		provider: provider,
+		clients:  provider.Meta().(SysdigClients),
+		file:     hclwrite.NewEmptyFile(),
+		labels:   map[string]bool{},
+	}
+	if *alerts {
+		if err := generator.alerts(ctx); err != nil {
+			return err
+		}
+	}
+	if *dashboards {
+		if err := generator.dashboards(ctx); err != nil {
+			return err
+		}
+	}
+
+	if *output == "" {
+		_, err := stdout.Write(generator.bytes())
+		return err
+	}
+	return os.WriteFile(*output, generator.bytes(), 0o644)
+}
+
+type configGenerator struct {
+	provider *schema.Provider
+	clients  SysdigClients
+	file     *hclwrite.File
+
+	// labels are the resource and data source addresses already used
+	labels map[string]bool
+	// channels are the notification channels which can be referenced, by ID
+	channels map[int]*generatedChannel
+	// channelBlocks are the data sources of the referenced notification channels
+	channelBlocks *hclwrite.File
+}
+
+type generatedChannel struct {
+	name       string
+	dataSource string
+	label      string
+	declared   bool
+}
+
+func (g *configGenerator) alerts(ctx context.Context) error {
+	client, err := getAlertV2Client(g.clients)
+	if err != nil {
+		return err
+	}
+
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing the alerts: %w", err)
+	}
+
+	if err := g.loadNotificationChannels(ctx); err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		resourceType := ""
+		for name, alertType := range alertV2Types {
+			if string(alertType) == alert.Type {
+				resourceType = "sysdig_monitor_alert_v2_" + name
+			}
+		}
+		if resourceType == "" {
+			g.file.Body().AppendUnstructuredTokens(comment(fmt.Sprintf("alert %d %q has the unsupported type %s", alert.ID, alert.Name, alert.Type)))
+			continue
+		}
+		if err := g.resource(ctx, resourceType, alert.ID, alert.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) dashboards(ctx context.Context) error {
+	client, err := getMonitorDashboardClient(g.clients)
+	if err != nil {
+		return err
+	}
+
+	dashboards, err := client.ListDashboards(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing the dashboards: %w", err)
+	}
+
+	for _, dashboard := range dashboards {
+		if err := g.resource(ctx, "sysdig_monitor_dashboard", dashboard.ID, dashboard.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadNotificationChannels indexes the notification channels, the alerts reference them
+// through data sources looking them up by name instead of by ID.
+func (g *configGenerator) loadNotificationChannels(ctx context.Context) error {
+	client, err := getMonitorNotificationChannelClient(g.clients)
+	if err != nil {
+		return err
+	}
+
+	channels, err := client.ListNotificationChannels(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing the notification channels: %w", err)
+	}
+
+	g.channels = map[int]*generatedChannel{}
+	g.channelBlocks = hclwrite.NewEmptyFile()
+	for _, channel := range channels {
+		if dataSource, ok := notificationChannelDataSources[channel.Type]; ok {
+			g.channels[channel.ID] = &generatedChannel{
+				name:       channel.Name,
+				dataSource: dataSource,
+				label:      g.label("data."+dataSource, channel.Name, channel.ID),
+			}
+		}
+	}
+	return nil
+}
+
+// resource reads the object with the provider resource, as an import would, and writes
+// its import block and the configuration matching the state read.
+func (g *configGenerator) resource(ctx context.Context, resourceType string, id int, name string) error {
+	resource, ok := g.provider.ResourcesMap[resourceType]
+	if !ok {
+		return fmt.Errorf("unknown resource %s", resourceType)
+	}
+
+	d := resource.Data(nil)
+	d.SetId(strconv.Itoa(id))
+	if diags := resource.ReadContext(ctx, d, g.clients); diags.HasError() {
+		return fmt.Errorf("error reading %s %d: %w", resourceType, id, diagnosticsError(diags))
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	label := g.label(resourceType, name, id)
+	body := g.file.Body()
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(d.Id()))
+	body.AppendNewline()
+
+	values := map[string]any{}
+	for key := range resource.Schema {
+		values[key] = d.Get(key)
+	}
+	g.writeBody(body.AppendNewBlock("resource", []string{resourceType, label}).Body(), resource.Schema, values, "")
+	body.AppendNewline()
+	return nil
+}
+
+// writeBody writes the configurable attributes and blocks of a schema, the attributes first as
+// terraform fmt does. The optional attributes left to their default value are skipped, while the
+// required ones are always written, even when their value is a zero value like a 0 threshold.
+func (g *configGenerator) writeBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]any, path string) {
+	var blocks []string
+	for _, key := range slices.Sorted(maps.Keys(s)) {
+		attribute := s[key]
+		if (!attribute.Optional && !attribute.Required) || attribute.Deprecated != "" {
+			continue
+		}
+		if _, ok := attribute.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, key)
+			continue
+		}
+
+		value := values[key]
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		if attribute.Optional && isDefaultValue(attribute, value) {
+			continue
+		}
+		if id, ok := value.(int); ok && path == "notification_channels." && key == "id" {
+			if reference, ok := g.channelReference(id); ok {
+				body.SetAttributeTraversal(key, reference)
+				continue
+			}
+		}
+		if ctyValue, ok := toCtyValue(value); ok {
+			body.SetAttributeValue(key, ctyValue)
+		}
+	}
+
+	for _, key := range blocks {
+		items := values[key]
+		if set, ok := items.(*schema.Set); ok {
+			items = set.List()
+		}
+		list, _ := items.([]any)
+		for _, item := range list {
+			if fields, ok := item.(map[string]any); ok {
+				g.writeBody(body.AppendNewBlock(key, nil).Body(), s[key].Elem.(*schema.Resource).Schema, fields, path+key+".")
+			}
+		}
+	}
+}
+
+// channelReference returns the reference to the data source of a notification channel,
+// declaring the data source the first time the channel is referenced.
+func (g *configGenerator) channelReference(id int) (hcl.Traversal, bool) {
+	channel, ok := g.channels[id]
+	if !ok {
+		return nil, false
+	}
+
+	if !channel.declared {
+		block := g.channelBlocks.Body().AppendNewBlock("data", []string{channel.dataSource, channel.label})
+		block.Body().SetAttributeValue("name", cty.StringVal(channel.name))
+		g.channelBlocks.Body().AppendNewline()
+		channel.declared = true
+	}
+
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "data"},
+		hcl.TraverseAttr{Name: channel.dataSource},
+		hcl.TraverseAttr{Name: channel.label},
+		hcl.TraverseAttr{Name: "id"},
+	}, true
+}
+
+// label returns a unique Terraform name for the object, derived from its name.
+func (g *configGenerator) label(resourceType, name string, id int) string {
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	switch {
+	case label == "":
+		label = fmt.Sprintf("id_%d", id)
+	case label[0] >= '0' && label[0] <= '9':
+		label = "_" + label
+	}
+	if g.labels[resourceType+"."+label] {
+		label = fmt.Sprintf("%s_%d", label, id)
+	}
+	g.labels[resourceType+"."+label] = true
+	return label
+}
+
+func (g *configGenerator) bytes() []byte {
+	var content []byte
+	if g.channelBlocks != nil {
+		content = append(content, g.channelBlocks.Bytes()...)
+	}
+	return hclwrite.Format(append(content, g.file.Bytes()...))
+}
+
+func isDefaultValue(attribute *schema.Schema, value any) bool {
+	if attribute.Default != nil {
+		return value == attribute.Default
+	}
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func toCtyValue(value any) (cty.Value, bool) {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v), true
+	case int:
+		return cty.NumberIntVal(int64(v)), true
+	case float64:
+		return cty.NumberFloatVal(v), true
+	case bool:
+		return cty.BoolVal(v), true
+	case []any:
+		values := make([]cty.Value, 0, len(v))
+		for _, item := range v {
+			if ctyValue, ok := toCtyValue(item); ok {
+				values = append(values, ctyValue)
+			}
+		}
+		return cty.TupleVal(values), true
+	case map[string]any:
+		values := make(map[string]cty.Value, len(v))
+		for key, item := range v {
+			if ctyValue, ok := toCtyValue(item); ok {
+				values[key] = ctyValue
+			}
+		}
+		return cty.ObjectVal(values), true
+	}
+	return cty.NilVal, false
+}
+
+func comment(text string) hclwrite.Tokens {
+	return hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte("# " + text + "\n")}}
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			errs = append(errs, fmt.Errorf("%s %s", d.Summary, d.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
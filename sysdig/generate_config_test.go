@@ -0,0 +1,107 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	ctx := context.Background()
+	_, client := newMockAPIMonitorProvider(t)
+	channel, err := client.CreateNotificationChannel(ctx, v2.NotificationChannel{
+		Type:    notificationChannelTypeEmail,
+		Name:    "On call",
+		Enabled: true,
+		Options: v2.NotificationChannelOptions{EmailRecipients: []string{"oncall@example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, name := range []string{"Disk full", "Disk full"} {
+		_, err = client.CreateAlertV2Prometheus(ctx, v2.AlertV2Prometheus{
+			AlertV2Common: v2.AlertV2Common{
+				Name:     name,
+				Type:     string(v2.AlertV2TypePrometheus),
+				Severity: "high",
+				Enabled:  true,
+				NotificationChannelConfigList: []v2.NotificationChannelConfigV2{
+					{ChannelID: channel.ID},
+				},
+			},
+			Config: v2.AlertV2ConfigPrometheus{Query: "up == 0", Duration: 300},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := client.CreateDashboard(ctx, &v2.Dashboard{Name: "Cluster overview"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := GenerateConfig(ctx, nil, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := out.String()
+
+	if _, diags := hclsyntax.ParseConfig(out.Bytes(), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("invalid configuration: %s\n%s", diags, config)
+	}
+	for _, expected := range []string{
+		`data "sysdig_monitor_notification_channel_email" "on_call" {`,
+		`to = sysdig_monitor_alert_v2_prometheus.disk_full`,
+		`to = sysdig_monitor_alert_v2_prometheus.disk_full_2`,
+		`resource "sysdig_monitor_alert_v2_prometheus" "disk_full" {`,
+		`= "up == 0"`,
+		`= data.sysdig_monitor_notification_channel_email.on_call.id`,
+		`to = sysdig_monitor_dashboard.cluster_overview`,
+		`resource "sysdig_monitor_dashboard" "cluster_overview" {`,
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected the configuration to contain %s, got:\n%s", expected, config)
+		}
+	}
+	if strings.Count(config, `data "sysdig_monitor_notification_channel_email"`) != 1 {
+		t.Errorf("expected the notification channel to be declared once, got:\n%s", config)
+	}
+}
+
+func TestGenerateConfigZeroValues(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"threshold": {Type: schema.TypeFloat, Required: true},
+		"operator":  {Type: schema.TypeString, Required: true},
+		"enabled":   {Type: schema.TypeBool, Optional: true, Default: true},
+		"group":     {Type: schema.TypeString, Optional: true},
+		"duration":  {Type: schema.TypeInt, Optional: true},
+	}
+	file := hclwrite.NewEmptyFile()
+	g := &configGenerator{}
+	g.writeBody(file.Body(), s, map[string]any{
+		"threshold": float64(0),
+		"operator":  ">",
+		"enabled":   true,
+		"group":     "",
+		"duration":  0,
+	}, "")
+
+	config := string(hclwrite.Format(file.Bytes()))
+	if !strings.Contains(config, "threshold = 0") {
+		t.Errorf("expected the required threshold to be written, got:\n%s", config)
+	}
+	for _, unexpected := range []string{"enabled", "group", "duration"} {
+		if strings.Contains(config, unexpected) {
+			t.Errorf("expected the optional %s left to its default not to be written, got:\n%s", unexpected, config)
+		}
+	}
+}
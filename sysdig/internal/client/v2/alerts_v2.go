@@ -2,7 +2,6 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -10,8 +9,6 @@ import (
 	"sync"
 )
 
-var ErrAlertV2NotFound = errors.New("alert not found")
-
 type (
 	AlertV2Type     string
 	AlertV2Severity string
@@ -33,6 +30,7 @@ const (
 	AlertV2TypeFormBasedPrometheus AlertV2Type = "FORM_BASED_PROMETHEUS"
 	AlertV2TypeGroupOutlier        AlertV2Type = "GROUP_OUTLIERS"
 	AlertV2TypeDowntime            AlertV2Type = "DOWNTIME"
+	AlertV2TypeAnomaly             AlertV2Type = "ANOMALY"
 )
 
 const (
@@ -42,12 +40,20 @@ const (
 	AlertV2SeverityInfo   AlertV2Severity = "info"
 )
 
+const (
+	AlertV2NoDataBehaviourDoNothing = "DO_NOTHING"
+	AlertV2NoDataBehaviourTrigger   = "TRIGGER"
+)
+
 const (
 	AlertLinkV2TypeDashboard         AlertLinkV2Type = "dashboard"
 	AlertLinkV2TypeDashboardTemplate AlertLinkV2Type = "dashboardTemplate"
 	AlertLinkV2TypeRunbook           AlertLinkV2Type = "runbook"
 )
 
+// ErrLabelNotFound matches, with errors.Is, the labels that GetLabelPublicID does not know.
+var ErrLabelNotFound = fmt.Errorf("label %w", ErrNotFound)
+
 var labelCache struct {
 	sync.Mutex
 
@@ -55,6 +61,10 @@ var labelCache struct {
 }
 
 type AlertV2Interface interface {
+	ListAlertsV2(ctx context.Context) ([]AlertV2Common, error)
+	GetAlertV2ByID(ctx context.Context, alertID int) (AlertV2Any, error)
+	UpdateAlertV2(ctx context.Context, alert AlertV2Any) (AlertV2Any, error)
+	GetLabelPublicID(ctx context.Context, id string) (string, error)
 	AlertV2PrometheusInterface
 	AlertV2EventInterface
 	AlertV2MetricInterface
@@ -62,6 +72,7 @@ type AlertV2Interface interface {
 	AlertV2ChangeInterface
 	AlertV2FormBasedPrometheusInterface
 	AlertV2GroupOutlierInterface
+	AlertV2AnomalyInterface
 }
 
 type AlertV2PrometheusInterface interface {
@@ -112,6 +123,14 @@ type AlertV2GroupOutlierInterface interface {
 	DeleteAlertV2GroupOutlier(ctx context.Context, alertID int) error
 }
 
+type AlertV2AnomalyInterface interface {
+	Base
+	CreateAlertV2Anomaly(ctx context.Context, alert AlertV2Anomaly) (AlertV2Anomaly, error)
+	UpdateAlertV2Anomaly(ctx context.Context, alert AlertV2Anomaly) (AlertV2Anomaly, error)
+	GetAlertV2AnomalyByID(ctx context.Context, alertID int) (AlertV2Anomaly, error)
+	DeleteAlertV2Anomaly(ctx context.Context, alertID int) error
+}
+
 type AlertV2DowntimeInterface interface {
 	Base
 	CreateAlertV2Downtime(ctx context.Context, alert AlertV2Downtime) (AlertV2Downtime, error)
@@ -536,6 +555,120 @@ func (c *Client) DeleteAlertV2GroupOutlier(ctx context.Context, alertID int) err
 	return c.deleteAlertV2(ctx, alertID)
 }
 
+func (c *Client) CreateAlertV2Anomaly(ctx context.Context, alert AlertV2Anomaly) (AlertV2Anomaly, error) {
+	err := c.addNotificationChannelType(ctx, alert.NotificationChannelConfigList)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	err = c.translateScopeSegmentLabels(ctx, &alert.Config.ScopedSegmentedConfig)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	payload, err := Marshal(alertV2AnomalyWrapper{Alert: alert})
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	wrapper, err := createAlertV2AndUnmarshal[alertV2AnomalyWrapper](ctx, c, payload)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	return wrapper.Alert, nil
+}
+
+func (c *Client) UpdateAlertV2Anomaly(ctx context.Context, alert AlertV2Anomaly) (AlertV2Anomaly, error) {
+	err := c.addNotificationChannelType(ctx, alert.NotificationChannelConfigList)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	err = c.translateScopeSegmentLabels(ctx, &alert.Config.ScopedSegmentedConfig)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	payload, err := Marshal(alertV2AnomalyWrapper{Alert: alert})
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	wrapper, err := updateAlertV2AndUnmarshal[alertV2AnomalyWrapper](ctx, c, alert.ID, payload)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	return wrapper.Alert, nil
+}
+
+func (c *Client) GetAlertV2AnomalyByID(ctx context.Context, alertID int) (AlertV2Anomaly, error) {
+	wrapper, err := getAlertV2[alertV2AnomalyWrapper](ctx, c, alertID)
+	if err != nil {
+		return AlertV2Anomaly{}, err
+	}
+
+	return wrapper.Alert, nil
+}
+
+func (c *Client) DeleteAlertV2Anomaly(ctx context.Context, alertID int) error {
+	return c.deleteAlertV2(ctx, alertID)
+}
+
+// GetAlertV2ByID returns an alert of any type, with its configuration as returned by the API.
+func (c *Client) GetAlertV2ByID(ctx context.Context, alertID int) (AlertV2Any, error) {
+	wrapper, err := getAlertV2[alertV2AnyWrapper](ctx, c, alertID)
+	if err != nil {
+		return AlertV2Any{}, err
+	}
+
+	return wrapper.Alert, nil
+}
+
+// UpdateAlertV2 updates the common fields of an alert of any type, sending its configuration back as it was read.
+func (c *Client) UpdateAlertV2(ctx context.Context, alert AlertV2Any) (AlertV2Any, error) {
+	err := c.addNotificationChannelType(ctx, alert.NotificationChannelConfigList)
+	if err != nil {
+		return AlertV2Any{}, err
+	}
+
+	payload, err := Marshal(alertV2AnyWrapper{Alert: alert})
+	if err != nil {
+		return AlertV2Any{}, err
+	}
+
+	wrapper, err := updateAlertV2AndUnmarshal[alertV2AnyWrapper](ctx, c, alert.ID, payload)
+	if err != nil {
+		return AlertV2Any{}, err
+	}
+
+	return wrapper.Alert, nil
+}
+
+// ListAlertsV2 returns the alerts of the current team, with their common fields only.
+func (c *Client) ListAlertsV2(ctx context.Context) (alerts []AlertV2Common, err error) {
+	response, err := c.requester.Request(ctx, http.MethodGet, c.alertsV2URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, c.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[alertsV2Wrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.Alerts, nil
+}
+
 func createAlertV2AndUnmarshal[T any](ctx context.Context, c *Client, alertJSON io.Reader) (value T, err error) {
 	var zero T
 
@@ -606,9 +739,6 @@ func getAlertV2[T any](ctx context.Context, c *Client, alertID int) (value T, er
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return zero, ErrAlertV2NotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return zero, c.ErrorFromResponse(response)
 	}
@@ -680,6 +810,28 @@ func (c *Client) getLabelDescriptor(ctx context.Context, label string) (LabelDes
 	return c.buildLabelDescriptor(ctx, label)
 }
 
+// GetLabelPublicID returns the public notation of a label in dot notation, as the legacy alerts reference them,
+// for example kube_cluster_name for kubernetes.cluster.name.
+func (c *Client) GetLabelPublicID(ctx context.Context, id string) (string, error) {
+	labelCache.Lock()
+	defer labelCache.Unlock()
+
+	if len(labelCache.labels) == 0 {
+		labelDescriptors, err := c.getLabels(ctx)
+		if err != nil {
+			return "", err
+		}
+		labelCache.labels = labelDescriptors
+	}
+
+	for _, l := range labelCache.labels {
+		if l.ID == id {
+			return l.PublicID, nil
+		}
+	}
+	return "", fmt.Errorf("label %s does not exist: %w", id, ErrLabelNotFound)
+}
+
 // buildLabelDescriptor gets the descriptor of a label in public notation from the v3/labels/descriptors api
 // this is not a general solution to get the descriptor for a public notation label since custom labels will not be properly translated
 func (c *Client) buildLabelDescriptor(ctx context.Context, label string) (descriptor LabelDescriptorV3, err error) {
@@ -0,0 +1,48 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetLabelPublicID(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"allLabels":[{"id":"kubernetes.cluster.name","publicId":"kube_cluster_name"}]}`))
+		}
+	}))
+	defer server.Close()
+	c := newSysdigClient(WithURL(server.URL), WithToken("test-token"))
+
+	resetLabelCache := func() {
+		labelCache.Lock()
+		labelCache.labels = nil
+		labelCache.Unlock()
+	}
+	resetLabelCache()
+	t.Cleanup(resetLabelCache)
+
+	publicID, err := c.GetLabelPublicID(context.Background(), "kubernetes.cluster.name")
+	if err != nil || publicID != "kube_cluster_name" {
+		t.Errorf("expected kube_cluster_name, got %q, %v", publicID, err)
+	}
+
+	if _, err := c.GetLabelPublicID(context.Background(), "kubernetes.node.name"); !errors.Is(err, ErrLabelNotFound) || !IsNotFound(err) {
+		t.Errorf("expected ErrLabelNotFound, got %v", err)
+	}
+
+	// the errors of the API are not reported as unknown labels
+	resetLabelCache()
+	status = http.StatusForbidden
+	if _, err := c.GetLabelPublicID(context.Background(), "kubernetes.cluster.name"); err == nil || errors.Is(err, ErrLabelNotFound) {
+		t.Errorf("expected the error of the API, got %v", err)
+	}
+}
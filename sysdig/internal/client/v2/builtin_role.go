@@ -2,14 +2,11 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 )
 
-var ErrBuiltinRoleNotFound = errors.New("builtin role not found")
-
 const builtinRolePath = "%s/platform/v1/default-roles/%s"
 
 type BuiltinRoleInterface interface {
@@ -29,9 +26,6 @@ func (c *Client) GetBuiltinRole(ctx context.Context, name string) (builtinRole *
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		if response.StatusCode == http.StatusNotFound {
-			return nil, ErrBuiltinRoleNotFound
-		}
 		return nil, c.ErrorFromResponse(response)
 	}
 
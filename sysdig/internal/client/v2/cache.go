@@ -0,0 +1,138 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// cachedListPaths are the list endpoints whose responses are kept in the read cache.
+// They are the collections walked by the data sources looking objects up by name.
+var cachedListPaths = []string{
+	"/api/notificationChannels",
+	"/api/teams",
+	"/api/users/light",
+	"/api/cspm/v1/policy/policies/list",
+	"/api/secure/rules/groups",
+	"/api/v2/alerts",
+}
+
+// cachingTransport keeps the responses of the list endpoints for the lifetime of the
+// client, which is the lifetime of a Terraform operation. Any write issued through the
+// client to the API flushes the whole cache, as it may have changed any of the collections.
+type cachingTransport struct {
+	next     http.RoundTripper
+	host     string
+	basePath string
+
+	lock       sync.Mutex
+	generation int
+	entries    map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	ready chan struct{}
+
+	status int
+	header http.Header
+	body   []byte
+	err    error
+}
+
+func newCachingTransport(next http.RoundTripper, baseURL string) *cachingTransport {
+	t := &cachingTransport{
+		next:    next,
+		entries: map[string]*cacheEntry{},
+	}
+	if u, err := url.Parse(baseURL); err == nil {
+		t.host = u.Host
+		t.basePath = strings.TrimSuffix(u.Path, "/")
+	}
+	return t
+}
+
+func (t *cachingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Host != t.host {
+		// e.g. the token exchanges with an identity provider
+		return t.next.RoundTrip(r)
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		t.invalidate()
+		return t.next.RoundTrip(r)
+	}
+	if !slices.Contains(cachedListPaths, strings.TrimPrefix(r.URL.Path, t.basePath)) {
+		return t.next.RoundTrip(r)
+	}
+
+	// the team header is part of the key, the same list differs between teams
+	key := r.Method + " " + r.URL.String() + " " + r.Header.Get(sysdigTeamIDHeader)
+
+	t.lock.Lock()
+	if entry, ok := t.entries[key]; ok {
+		t.lock.Unlock()
+		select {
+		case <-entry.ready:
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		}
+		if entry.err == nil {
+			return entry.response(r), nil
+		}
+		return t.next.RoundTrip(r)
+	}
+	entry := &cacheEntry{ready: make(chan struct{})}
+	t.entries[key] = entry
+	generation := t.generation
+	t.lock.Unlock()
+
+	response, err := t.next.RoundTrip(r)
+	if err == nil {
+		entry.status = response.StatusCode
+		entry.header = response.Header.Clone()
+		entry.body, err = io.ReadAll(response.Body)
+		_ = response.Body.Close()
+	}
+	entry.err = err
+	close(entry.ready)
+
+	t.lock.Lock()
+	// failures and the listings which raced with a write are not kept
+	if err != nil || entry.status != http.StatusOK || generation != t.generation {
+		if t.entries[key] == entry {
+			delete(t.entries, key)
+		}
+	}
+	t.lock.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return entry.response(r), nil
+}
+
+func (t *cachingTransport) invalidate() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.generation++
+	t.entries = map[string]*cacheEntry{}
+}
+
+func (e *cacheEntry) response(r *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       r,
+	}
+}
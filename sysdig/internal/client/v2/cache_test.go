@@ -0,0 +1,107 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachingTransport(t *testing.T) {
+	t.Parallel()
+
+	var listings, reads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == ibmIAMPath:
+			_, _ = fmt.Fprintf(w, `{"access_token":"token","expiration":%d}`, time.Now().Add(time.Hour).Unix())
+		case r.Method == http.MethodGet && r.URL.Path == "/api/notificationChannels":
+			listings.Add(1)
+			_, _ = w.Write([]byte(`{"notificationChannels":[]}`))
+		case r.Method == http.MethodGet:
+			reads.Add(1)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	// the IBM client, as the only one issuing the requests of a context in another team
+	teamID := 1
+	client := newIBMClient(WithURL(server.URL), WithIBMIamURL(server.URL), WithIBMInstanceID("instance"), WithIBMAPIKey("key"), WithSysdigTeamID(&teamID), WithReadCache(true))
+	ctx := context.Background()
+	send := func(ctx context.Context, method, path string) string {
+		t.Helper()
+		response, err := client.requester.Request(ctx, method, server.URL+path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatalf("failed to read response body, %v", err)
+		}
+		return string(body)
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			if body := send(ctx, http.MethodGet, "/api/notificationChannels"); body != `{"notificationChannels":[]}` {
+				t.Errorf("unexpected cached body %s", body)
+			}
+		})
+	}
+	wg.Wait()
+	if got := listings.Load(); got != 1 {
+		t.Errorf("expected a single listing, got %d", got)
+	}
+
+	// another team gets its own listing
+	send(WithTeamOverride(ctx, TeamOverride{ID: 2}), http.MethodGet, "/api/notificationChannels")
+	if got := listings.Load(); got != 2 {
+		t.Errorf("expected a listing for the other team, got %d", got)
+	}
+
+	// objects are not cached, only lists
+	send(ctx, http.MethodGet, "/api/notificationChannels/1")
+	send(ctx, http.MethodGet, "/api/notificationChannels/1")
+	if got := reads.Load(); got != 2 {
+		t.Errorf("expected objects to be read every time, got %d reads", got)
+	}
+
+	send(ctx, http.MethodDelete, "/api/notificationChannels/1")
+	send(ctx, http.MethodGet, "/api/notificationChannels")
+	if got := listings.Load(); got != 3 {
+		t.Errorf("expected the write to invalidate the cache, got %d listings", got)
+	}
+}
+
+func TestCachingTransport_Disabled(t *testing.T) {
+	t.Parallel()
+
+	var listings atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listings.Add(1)
+		_, _ = w.Write([]byte(`{"teams":[]}`))
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	for range 2 {
+		if _, err := client.ListTeams(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := listings.Load(); got != 2 {
+		t.Errorf("expected no caching by default, got %d listings", got)
+	}
+}
@@ -10,9 +10,7 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"net/http/httputil"
 	"strings"
-	"time"
 
 	"github.com/draios/terraform-provider-sysdig/buildinfo"
 	"github.com/hashicorp/go-retryablehttp"
@@ -31,6 +29,7 @@ const (
 	ContentTypeJSON            = "application/json"
 	ContentTypeFormURLEncoded  = "x-www-form-urlencoded"
 	SysdigProductHeader        = "X-Sysdig-Product"
+	RequestIDHeader            = "X-Request-Id"
 )
 
 var errMissingCurrentTeam = errors.New("missing user's current team")
@@ -51,6 +50,7 @@ type MonitorCommon interface {
 	AlertInterface
 	AlertV2Interface
 	DashboardInterface
+	DashboardFolderInterface
 	SilenceRuleInterface
 	InhibitionRuleInterface
 }
@@ -76,12 +76,30 @@ type Client struct {
 	requester Requester
 }
 
+// APIError is returned by the client methods when the API answers with an unexpected status code.
 type APIError struct {
-	StatusCode int
-	Status     string
-	Message    string
+	StatusCode  int
+	Status      string
+	RequestID   string
+	Message     string
+	FieldErrors []APIFieldError
 }
 
+// APIFieldError is an entry of the errors array some endpoints answer with.
+type APIFieldError struct {
+	Field   string
+	Reason  string
+	Message string
+}
+
+var (
+	// ErrNotFound matches, with errors.Is, any APIError with a 404 status code
+	// as well as the not found errors returned when looking up objects in a list.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches, with errors.Is, any APIError with a 409 status code.
+	ErrConflict = errors.New("conflict")
+)
+
 func (e *APIError) Error() string {
 	if e.Message != "" {
 		return e.Message
@@ -92,57 +110,82 @@ func (e *APIError) Error() string {
 	return "api error"
 }
 
-func (c *Client) ErrorFromResponse(response *http.Response) error {
-	var data any
-	err := json.NewDecoder(response.Body).Decode(&data)
-	if err != nil {
-		return errors.New(response.Status)
-	}
-
-	search, err := jmespath.Search("[message, error, details[], errors[].[reason, message]][][] | join(', ', @)", data)
-	if err != nil {
-		return errors.New(response.Status)
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
 	}
+	return false
+}
 
-	if searchArray, ok := search.([]any); ok {
-		return errors.New(strings.Join(cast.ToStringSlice(searchArray), ", "))
-	}
+// IsNotFound reports whether err means the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
 
-	searchString := cast.ToString(search)
-	if searchString != "" {
-		return errors.New(searchString)
-	}
+// IsConflict reports whether err is an APIError with a 409 status code.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
 
-	return errors.New(response.Status)
+// ErrorFromResponse extracts the error details from an API response into an APIError.
+func (c *Client) ErrorFromResponse(response *http.Response) error {
+	return errorFromResponse(response)
 }
 
-// APIErrorFromResponse Introduces a new method that extracts error details from the API response and constructs an APIError with the relevant information.
-func (c *Client) APIErrorFromResponse(response *http.Response) error {
-	statusCode := response.StatusCode
-	status := response.Status
+func errorFromResponse(response *http.Response) error {
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Status:     response.Status,
+		RequestID:  response.Header.Get(RequestIDHeader),
+	}
 
 	var data any
 	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
-		return &APIError{StatusCode: statusCode, Status: status}
+		return apiErr
 	}
 
+	apiErr.FieldErrors = fieldErrorsFromData(data)
+
 	search, err := jmespath.Search("[message, error, details[], errors[].[reason, message]][][] | join(', ', @)", data)
 	if err != nil {
-		return &APIError{StatusCode: statusCode, Status: status}
+		return apiErr
 	}
 
-	msg := ""
 	if searchArray, ok := search.([]any); ok {
-		msg = strings.Join(cast.ToStringSlice(searchArray), ", ")
+		apiErr.Message = strings.Join(cast.ToStringSlice(searchArray), ", ")
 	} else {
-		msg = cast.ToString(search)
+		apiErr.Message = cast.ToString(search)
+	}
+
+	return apiErr
+}
+
+func fieldErrorsFromData(data any) []APIFieldError {
+	object, ok := data.(map[string]any)
+	if !ok {
+		return nil
+	}
+	entries, ok := object["errors"].([]any)
+	if !ok {
+		return nil
 	}
 
-	return &APIError{
-		StatusCode: statusCode,
-		Status:     status,
-		Message:    msg,
+	var fieldErrors []APIFieldError
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		fieldErrors = append(fieldErrors, APIFieldError{
+			Field:   cast.ToString(fields["field"]),
+			Reason:  cast.ToString(fields["reason"]),
+			Message: cast.ToString(fields["message"]),
+		})
 	}
+	return fieldErrors
 }
 
 func Unmarshal[T any](data io.Reader) (T, error) {
@@ -163,15 +206,21 @@ func Marshal[T any](data T) (io.Reader, error) {
 }
 
 func request(httpClient *http.Client, cfg *config, request *http.Request) (*http.Response, error) {
-	request.Header.Set(UserAgentHeader, fmt.Sprintf("%s/%s", SysdigUserAgentHeaderValue, buildinfo.Version))
-
 	if cfg.extraHeaders != nil {
 		for key, value := range cfg.extraHeaders {
 			request.Header.Set(key, value)
 		}
 	}
 
-	out, err := httputil.DumpRequestOut(request, true)
+	return send(httpClient, cfg, request)
+}
+
+// send sends a request without the extra headers, which are only meant for the Sysdig API and
+// must not leak to third parties like the OAuth token endpoint.
+func send(httpClient *http.Client, cfg *config, request *http.Request) (*http.Response, error) {
+	request.Header.Set(UserAgentHeader, fmt.Sprintf("%s/%s", SysdigUserAgentHeaderValue, buildinfo.Version))
+
+	out, err := dumpRequest(cfg, request)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +232,7 @@ func request(httpClient *http.Client, cfg *config, request *http.Request) (*http
 		return response, err
 	}
 
-	out, err = httputil.DumpResponse(response, true)
+	out, err = dumpResponse(cfg, response)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +265,10 @@ func getMe(ctx context.Context, cfg *config, httpClient *http.Client, headers ma
 		}
 	}()
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
 	wrapper, err := Unmarshal[userWrapper](resp.Body)
 	if err != nil {
 		return nil, err
@@ -231,29 +284,32 @@ func (c *Client) CurrentTeamID(ctx context.Context) (int, error) {
 func newHTTPClient(cfg *config) *http.Client {
 	httpClient := retryablehttp.NewClient()
 	transport := http.DefaultTransport.(*http.Transport).Clone()
-	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: cfg.insecure}
-	httpClient.HTTPClient = &http.Client{Transport: transport}
-
-	// Configure retry logic for 409 Conflict errors with exponential backoff
-	httpClient.RetryMax = 5
-	httpClient.RetryWaitMin = 1 * time.Second
-	httpClient.RetryWaitMax = 30 * time.Second
-	httpClient.Backoff = retryablehttp.DefaultBackoff // Exponential backoff strategy
-
-	httpClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
-		// Use default retry logic for connection errors and 5xx
-		shouldRetry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
-		if shouldRetry || checkErr != nil {
-			return shouldRetry, checkErr
-		}
-
-		// Additionally retry on 409 Conflict
-		if resp != nil && resp.StatusCode == http.StatusConflict {
-			return true, nil
-		}
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: cfg.insecure,
+		RootCAs:            cfg.rootCAs,
+		Certificates:       cfg.clientCertificates,
+	}
+	if cfg.proxyURL != nil {
+		transport.Proxy = http.ProxyURL(cfg.proxyURL)
+	}
+	httpClient.HTTPClient = &http.Client{
+		Transport: newRateLimitedTransport(transport, cfg.requestsPerSecond, cfg.maxConcurrentRequests),
+	}
 
-		return false, nil
+	httpClient.RetryMax = defaultRetryMax
+	if cfg.retryMax != nil {
+		httpClient.RetryMax = *cfg.retryMax
 	}
+	httpClient.RetryWaitMin, httpClient.RetryWaitMax = retryWaitBounds(cfg.retryWaitMin, cfg.retryWaitMax)
+	httpClient.Backoff = retryablehttp.DefaultBackoff // Exponential backoff strategy, waits for Retry-After on 429 and 503
+	httpClient.CheckRetry = cfg.checkRetry
 
-	return httpClient.StandardClient()
+	client := httpClient.StandardClient()
+	if cfg.idempotentRetriesOnly {
+		client.Transport = &nonIdempotentTransport{next: client.Transport}
+	}
+	if cfg.readCache {
+		client.Transport = newCachingTransport(client.Transport, cfg.url)
+	}
+	return client
 }
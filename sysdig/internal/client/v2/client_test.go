@@ -3,13 +3,24 @@
 package v2
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestMarshal(t *testing.T) {
@@ -170,7 +181,7 @@ func TestClient_ErrorFromResponse_json_nonStandard_error_format(t *testing.T) {
 	}
 }
 
-func TestClient_APIErrorFromResponse(t *testing.T) {
+func TestClient_ErrorFromResponse_APIError(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -252,7 +263,7 @@ func TestClient_APIErrorFromResponse(t *testing.T) {
 				Body:       io.NopCloser(strings.NewReader(tt.body)),
 			}
 
-			err := c.APIErrorFromResponse(resp)
+			err := c.ErrorFromResponse(resp)
 
 			apiErr, ok := err.(*APIError)
 			if !ok {
@@ -268,6 +279,68 @@ func TestClient_APIErrorFromResponse(t *testing.T) {
 	}
 }
 
+func TestClient_ErrorFromResponse_details(t *testing.T) {
+	t.Parallel()
+
+	c := Client{}
+	resp := &http.Response{
+		StatusCode: http.StatusUnprocessableEntity,
+		Status:     "422 Unprocessable Entity",
+		Header:     http.Header{RequestIDHeader: []string{"abc-123"}},
+		Body: io.NopCloser(strings.NewReader(`{"errors":[
+			{"field":"name","reason":"validation_error","message":"name is required"}
+		]}`)),
+	}
+
+	err := c.ErrorFromResponse(resp)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.RequestID != "abc-123" {
+		t.Errorf("RequestID: want %q, got %q", "abc-123", apiErr.RequestID)
+	}
+	want := []APIFieldError{{Field: "name", Reason: "validation_error", Message: "name is required"}}
+	if !reflect.DeepEqual(apiErr.FieldErrors, want) {
+		t.Errorf("FieldErrors: want %v, got %v", want, apiErr.FieldErrors)
+	}
+}
+
+func TestIsNotFound_IsConflict(t *testing.T) {
+	t.Parallel()
+
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	conflict := &APIError{StatusCode: http.StatusConflict}
+
+	tests := []struct {
+		name         string
+		err          error
+		wantNotFound bool
+		wantConflict bool
+	}{
+		{name: "not found", err: notFound, wantNotFound: true},
+		{name: "wrapped not found", err: fmt.Errorf("reading: %w", notFound), wantNotFound: true},
+		{name: "conflict", err: conflict, wantConflict: true},
+		{name: "lookup sentinel", err: fmt.Errorf("custom role with name, foo does not exist: %w", ErrCustomRoleNotFound), wantNotFound: true},
+		{name: "other status", err: &APIError{StatusCode: http.StatusBadRequest}},
+		{name: "other error", err: errors.New("boom")},
+		{name: "nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsNotFound(tt.err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound: want %v, got %v", tt.wantNotFound, got)
+			}
+			if got := IsConflict(tt.err); got != tt.wantConflict {
+				t.Errorf("IsConflict: want %v, got %v", tt.wantConflict, got)
+			}
+		})
+	}
+}
+
 func TestRequest(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		agent := r.Header.Get(UserAgentHeader)
@@ -292,3 +365,79 @@ func TestRequest(t *testing.T) {
 		t.Errorf("failed to send request, %v", err)
 	}
 }
+
+func TestNewHTTPClient_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = fmt.Fprint(w, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	certificate := newTestCertificate(t, "terraform")
+
+	client := newHTTPClient(&config{rootCAs: rootCAs, clientCertificates: []tls.Certificate{certificate}})
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK || string(body) != "terraform" {
+		t.Errorf("expected the client certificate to be presented, got %d %s", response.StatusCode, body)
+	}
+
+	if _, err := newHTTPClient(&config{}).Get(server.URL); err == nil {
+		t.Error("expected the server certificate to be rejected without the CA")
+	}
+}
+
+func TestNewHTTPClient_Proxy(t *testing.T) {
+	t.Parallel()
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, r.URL.String())
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	client := newHTTPClient(&config{proxyURL: proxyURL})
+	response, err := client.Get("http://sysdig.example.com/api/users/me")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	if string(body) != "http://sysdig.example.com/api/users/me" {
+		t.Errorf("expected the request to go through the proxy, got %s", body)
+	}
+}
+
+func newTestCertificate(t *testing.T, commonName string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
@@ -3,7 +3,6 @@ package v2
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,21 +19,21 @@ const (
 
 type CloudauthAccountSecureInterface interface {
 	Base
-	CreateCloudauthAccountSecure(ctx context.Context, cloudAccount *CloudauthAccountSecure) (*CloudauthAccountSecure, string, error)
-	GetCloudauthAccountSecureByID(ctx context.Context, accountID string) (*CloudauthAccountSecure, string, error)
-	DeleteCloudauthAccountSecure(ctx context.Context, accountID string) (string, error)
-	UpdateCloudauthAccountSecure(ctx context.Context, accountID string, cloudAccount *CloudauthAccountSecure) (*CloudauthAccountSecure, string, error)
+	CreateCloudauthAccountSecure(ctx context.Context, cloudAccount *CloudauthAccountSecure) (*CloudauthAccountSecure, error)
+	GetCloudauthAccountSecureByID(ctx context.Context, accountID string) (*CloudauthAccountSecure, error)
+	DeleteCloudauthAccountSecure(ctx context.Context, accountID string) error
+	UpdateCloudauthAccountSecure(ctx context.Context, accountID string, cloudAccount *CloudauthAccountSecure) (*CloudauthAccountSecure, error)
 }
 
-func (c *Client) CreateCloudauthAccountSecure(ctx context.Context, cloudAccount *CloudauthAccountSecure) (account *CloudauthAccountSecure, errStatus string, err error) {
+func (c *Client) CreateCloudauthAccountSecure(ctx context.Context, cloudAccount *CloudauthAccountSecure) (account *CloudauthAccountSecure, err error) {
 	payload, err := c.marshalCloudauthProto(cloudAccount)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPost, c.cloudauthAccountsURL(), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -43,23 +42,22 @@ func (c *Client) CreateCloudauthAccountSecure(ctx context.Context, cloudAccount
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccount := &CloudauthAccountSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccount)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccount, "", nil
+	return cloudauthAccount, nil
 }
 
-func (c *Client) GetCloudauthAccountSecureByID(ctx context.Context, accountID string) (account *CloudauthAccountSecure, errStatus string, err error) {
+func (c *Client) GetCloudauthAccountSecureByID(ctx context.Context, accountID string) (account *CloudauthAccountSecure, err error) {
 	// get the cloud account with decrypt query param true to fetch decrypted details on the cloud account
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getCloudauthAccountURL(accountID, "true"), nil)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -68,22 +66,21 @@ func (c *Client) GetCloudauthAccountSecureByID(ctx context.Context, accountID st
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccount := &CloudauthAccountSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccount)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccount, "", nil
+	return cloudauthAccount, nil
 }
 
-func (c *Client) DeleteCloudauthAccountSecure(ctx context.Context, accountID string) (errStatus string, err error) {
+func (c *Client) DeleteCloudauthAccountSecure(ctx context.Context, accountID string) (err error) {
 	response, err := c.requester.Request(ctx, http.MethodDelete, c.cloudauthAccountURL(accountID), nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -92,20 +89,20 @@ func (c *Client) DeleteCloudauthAccountSecure(ctx context.Context, accountID str
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
-		return c.ErrorAndStatusFromResponse(response)
+		return c.ErrorFromResponse(response)
 	}
-	return "", nil
+	return nil
 }
 
-func (c *Client) UpdateCloudauthAccountSecure(ctx context.Context, accountID string, cloudAccount *CloudauthAccountSecure) (account *CloudauthAccountSecure, errString string, err error) {
+func (c *Client) UpdateCloudauthAccountSecure(ctx context.Context, accountID string, cloudAccount *CloudauthAccountSecure) (account *CloudauthAccountSecure, err error) {
 	payload, err := c.marshalCloudauthProto(cloudAccount)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPut, c.cloudauthAccountURL(accountID), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -114,16 +111,15 @@ func (c *Client) UpdateCloudauthAccountSecure(ctx context.Context, accountID str
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccount := &CloudauthAccountSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccount)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccount, "", nil
+	return cloudauthAccount, nil
 }
 
 func (c *Client) cloudauthAccountsURL() string {
@@ -153,11 +149,3 @@ func (c *Client) unmarshalCloudauthProto(data io.ReadCloser, message proto.Messa
 	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, message)
 	return err
 }
-
-func (c *Client) ErrorAndStatusFromResponse(response *http.Response) (string, error) {
-	b, err := io.ReadAll(response.Body)
-	if err != nil {
-		return response.Status, err
-	}
-	return response.Status, errors.New(string(b))
-}
@@ -13,21 +13,21 @@ const (
 
 type CloudauthAccountComponentSecureInterface interface {
 	Base
-	CreateCloudauthAccountComponentSecure(ctx context.Context, accountID string, cloudAccountComponent *CloudauthAccountComponentSecure) (*CloudauthAccountComponentSecure, string, error)
-	GetCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (*CloudauthAccountComponentSecure, string, error)
-	DeleteCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (string, error)
-	UpdateCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string, cloudAccountComponent *CloudauthAccountComponentSecure) (*CloudauthAccountComponentSecure, string, error)
+	CreateCloudauthAccountComponentSecure(ctx context.Context, accountID string, cloudAccountComponent *CloudauthAccountComponentSecure) (*CloudauthAccountComponentSecure, error)
+	GetCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (*CloudauthAccountComponentSecure, error)
+	DeleteCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) error
+	UpdateCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string, cloudAccountComponent *CloudauthAccountComponentSecure) (*CloudauthAccountComponentSecure, error)
 }
 
-func (c *Client) CreateCloudauthAccountComponentSecure(ctx context.Context, accountID string, cloudAccountComponent *CloudauthAccountComponentSecure) (component *CloudauthAccountComponentSecure, errString string, err error) {
+func (c *Client) CreateCloudauthAccountComponentSecure(ctx context.Context, accountID string, cloudAccountComponent *CloudauthAccountComponentSecure) (component *CloudauthAccountComponentSecure, err error) {
 	payload, err := c.marshalCloudauthProto(cloudAccountComponent)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPost, c.cloudauthAccountComponentsURL(accountID), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -36,22 +36,21 @@ func (c *Client) CreateCloudauthAccountComponentSecure(ctx context.Context, acco
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccountComponent := &CloudauthAccountComponentSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccountComponent)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccountComponent, "", nil
+	return cloudauthAccountComponent, nil
 }
 
-func (c *Client) GetCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (component *CloudauthAccountComponentSecure, errString string, err error) {
+func (c *Client) GetCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (component *CloudauthAccountComponentSecure, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.cloudauthAccountComponentURL(accountID, componentType, componentInstance), nil)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -60,22 +59,21 @@ func (c *Client) GetCloudauthAccountComponentSecure(ctx context.Context, account
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccountComponent := &CloudauthAccountComponentSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccountComponent)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccountComponent, "", nil
+	return cloudauthAccountComponent, nil
 }
 
-func (c *Client) DeleteCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (errString string, err error) {
+func (c *Client) DeleteCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string) (err error) {
 	response, err := c.requester.Request(ctx, http.MethodDelete, c.cloudauthAccountComponentURL(accountID, componentType, componentInstance), nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -84,20 +82,20 @@ func (c *Client) DeleteCloudauthAccountComponentSecure(ctx context.Context, acco
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
-		return c.ErrorAndStatusFromResponse(response)
+		return c.ErrorFromResponse(response)
 	}
-	return "", nil
+	return nil
 }
 
-func (c *Client) UpdateCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string, cloudAccountComponent *CloudauthAccountComponentSecure) (component *CloudauthAccountComponentSecure, errString string, err error) {
+func (c *Client) UpdateCloudauthAccountComponentSecure(ctx context.Context, accountID, componentType, componentInstance string, cloudAccountComponent *CloudauthAccountComponentSecure) (component *CloudauthAccountComponentSecure, err error) {
 	payload, err := c.marshalCloudauthProto(cloudAccountComponent)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPut, c.cloudauthAccountComponentURL(accountID, componentType, componentInstance), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -106,16 +104,15 @@ func (c *Client) UpdateCloudauthAccountComponentSecure(ctx context.Context, acco
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccountComponent := &CloudauthAccountComponentSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccountComponent)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccountComponent, "", nil
+	return cloudauthAccountComponent, nil
 }
 
 func (c *Client) cloudauthAccountComponentsURL(accountID string) string {
@@ -12,20 +12,20 @@ const (
 
 type CloudauthAccountFeatureSecureInterface interface {
 	Base
-	CreateOrUpdateCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string, cloudAccountFeature *CloudauthAccountFeatureSecure) (*CloudauthAccountFeatureSecure, string, error)
-	GetCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (*CloudauthAccountFeatureSecure, string, error)
-	DeleteCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (string, error)
+	CreateOrUpdateCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string, cloudAccountFeature *CloudauthAccountFeatureSecure) (*CloudauthAccountFeatureSecure, error)
+	GetCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (*CloudauthAccountFeatureSecure, error)
+	DeleteCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) error
 }
 
-func (c *Client) CreateOrUpdateCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string, cloudAccountFeature *CloudauthAccountFeatureSecure) (feature *CloudauthAccountFeatureSecure, statusCode string, err error) {
+func (c *Client) CreateOrUpdateCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string, cloudAccountFeature *CloudauthAccountFeatureSecure) (feature *CloudauthAccountFeatureSecure, err error) {
 	payload, err := c.marshalCloudauthProto(cloudAccountFeature)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPut, c.cloudauthAccountFeatureURL(accountID, featureType), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -34,22 +34,21 @@ func (c *Client) CreateOrUpdateCloudauthAccountFeatureSecure(ctx context.Context
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccountFeature := &CloudauthAccountFeatureSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccountFeature)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccountFeature, "", nil
+	return cloudauthAccountFeature, nil
 }
 
-func (c *Client) GetCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (feature *CloudauthAccountFeatureSecure, statusCode string, err error) {
+func (c *Client) GetCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (feature *CloudauthAccountFeatureSecure, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.cloudauthAccountFeatureURL(accountID, featureType), nil)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -58,22 +57,21 @@ func (c *Client) GetCloudauthAccountFeatureSecure(ctx context.Context, accountID
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	cloudauthAccountFeature := &CloudauthAccountFeatureSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, cloudauthAccountFeature)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return cloudauthAccountFeature, "", nil
+	return cloudauthAccountFeature, nil
 }
 
-func (c *Client) DeleteCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (statusCode string, err error) {
+func (c *Client) DeleteCloudauthAccountFeatureSecure(ctx context.Context, accountID, featureType string) (err error) {
 	response, err := c.requester.Request(ctx, http.MethodDelete, c.cloudauthAccountFeatureURL(accountID, featureType), nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -82,9 +80,9 @@ func (c *Client) DeleteCloudauthAccountFeatureSecure(ctx context.Context, accoun
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
-		return c.ErrorAndStatusFromResponse(response)
+		return c.ErrorFromResponse(response)
 	}
-	return "", nil
+	return nil
 }
 
 func (c *Client) cloudauthAccountFeatureURL(accountID string, featureType string) string {
@@ -30,8 +30,8 @@ type CompositePolicyInterface interface {
 	CreateCompositePolicy(ctx context.Context, policy PolicyRulesComposite) (PolicyRulesComposite, error)
 	DeleteCompositePolicy(ctx context.Context, policyID int) error
 	UpdateCompositePolicy(ctx context.Context, policy PolicyRulesComposite) (PolicyRulesComposite, error)
-	GetCompositePolicyByID(ctx context.Context, policyID int) (PolicyRulesComposite, int, error)
-	ListCompositePoliciesByNameAndType(ctx context.Context, policyType string, policyName string) ([]PolicyRulesComposite, int, error)
+	GetCompositePolicyByID(ctx context.Context, policyID int) (PolicyRulesComposite, error)
+	ListCompositePoliciesByNameAndType(ctx context.Context, policyType string, policyName string) ([]PolicyRulesComposite, error)
 }
 
 func (c *Client) CreateCompositePolicy(ctx context.Context, policy PolicyRulesComposite) (policyComposite PolicyRulesComposite, err error) {
@@ -98,10 +98,10 @@ func (c *Client) DeleteCompositePolicy(ctx context.Context, policyID int) (err e
 	return err
 }
 
-func (c *Client) GetCompositePolicyByID(ctx context.Context, policyID int) (policyComposite PolicyRulesComposite, statusCode int, err error) {
+func (c *Client) GetCompositePolicyByID(ctx context.Context, policyID int) (policyComposite PolicyRulesComposite, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getCompositePolicyURL(policyID), nil)
 	if err != nil {
-		return PolicyRulesComposite{}, 0, err
+		return PolicyRulesComposite{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -110,12 +110,12 @@ func (c *Client) GetCompositePolicyByID(ctx context.Context, policyID int) (poli
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return PolicyRulesComposite{}, response.StatusCode, c.ErrorFromResponse(response)
+		return PolicyRulesComposite{}, c.ErrorFromResponse(response)
 	}
 
 	policy, err := Unmarshal[Policy](response.Body)
 	if err != nil {
-		return PolicyRulesComposite{}, 0, err
+		return PolicyRulesComposite{}, err
 	}
 
 	names := []string{}
@@ -123,25 +123,25 @@ func (c *Client) GetCompositePolicyByID(ctx context.Context, policyID int) (poli
 		names = append(names, rule.Name)
 	}
 
-	rules, _, err := c.getCompositePolicyRulesByName(ctx, names)
+	rules, err := c.getCompositePolicyRulesByName(ctx, names)
 	if err != nil {
-		return PolicyRulesComposite{}, 0, err
+		return PolicyRulesComposite{}, err
 	}
 
 	return PolicyRulesComposite{
 		Policy: &policy,
 		Rules:  rules,
-	}, http.StatusOK, nil
+	}, nil
 }
 
-func (c *Client) getCompositePolicyRulesByName(ctx context.Context, names []string) (policies []*RuntimePolicyRule, statusCode int, err error) {
+func (c *Client) getCompositePolicyRulesByName(ctx context.Context, names []string) (policies []*RuntimePolicyRule, err error) {
 	if len(names) == 0 {
-		return nil, http.StatusOK, errors.New("please provide at least one rule name")
+		return nil, errors.New("please provide at least one rule name")
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getCompositePolicyRulesURL(names), nil)
 	if err != nil {
-		return []*RuntimePolicyRule{}, 0, err
+		return []*RuntimePolicyRule{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -150,12 +150,12 @@ func (c *Client) getCompositePolicyRulesByName(ctx context.Context, names []stri
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return []*RuntimePolicyRule{}, response.StatusCode, c.ErrorFromResponse(response)
+		return []*RuntimePolicyRule{}, c.ErrorFromResponse(response)
 	}
 
 	unmarshalled, err := Unmarshal[[][]*RuntimePolicyRule](response.Body)
 	if err != nil {
-		return []*RuntimePolicyRule{}, 0, err
+		return []*RuntimePolicyRule{}, err
 	}
 
 	rules := []*RuntimePolicyRule{}
@@ -164,20 +164,20 @@ func (c *Client) getCompositePolicyRulesByName(ctx context.Context, names []stri
 	}
 
 	if len(rules) == 0 {
-		return nil, http.StatusOK, errors.New("rules not found")
+		return nil, errors.New("rules not found")
 	}
 
-	return rules, http.StatusOK, nil
+	return rules, nil
 }
 
 // ListCompositePoliciesByNameAndType is used in a data source to retrieve a policy by name and type.
 // We must retrieve and iterate over all policies, as there is no endpoint to get a policy by name.
-func (c *Client) ListCompositePoliciesByNameAndType(ctx context.Context, policyType string, policyName string) (list []PolicyRulesComposite, statusCode int, err error) {
+func (c *Client) ListCompositePoliciesByNameAndType(ctx context.Context, policyType string, policyName string) (list []PolicyRulesComposite, err error) {
 	// TODO: Implement pagination in order to get all policies?
 	q := getPoliciesQueryParams{policyType, policyName, getPoliciesLimit}
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getCompositePoliciesURL(q), nil)
 	if err != nil {
-		return []PolicyRulesComposite{}, 0, err
+		return []PolicyRulesComposite{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -186,16 +186,16 @@ func (c *Client) ListCompositePoliciesByNameAndType(ctx context.Context, policyT
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return []PolicyRulesComposite{}, response.StatusCode, c.ErrorFromResponse(response)
+		return []PolicyRulesComposite{}, c.ErrorFromResponse(response)
 	}
 
 	policies, err := Unmarshal[[]Policy](response.Body) // TODO
 	if err != nil {
-		return []PolicyRulesComposite{}, 0, err
+		return []PolicyRulesComposite{}, err
 	}
 
 	if len(policies) == 0 {
-		return []PolicyRulesComposite{}, 0, fmt.Errorf("Policy was not found: %s %s", policyType, policyName)
+		return []PolicyRulesComposite{}, fmt.Errorf("Policy was not found: %s %s", policyType, policyName)
 	}
 
 	compositePoliciesByPolicyID := map[int]PolicyRulesComposite{}
@@ -214,13 +214,13 @@ func (c *Client) ListCompositePoliciesByNameAndType(ctx context.Context, policyT
 		}
 	}
 
-	rules, _, err := c.getCompositePolicyRulesByName(ctx, names)
+	rules, err := c.getCompositePolicyRulesByName(ctx, names)
 	if err != nil {
-		return []PolicyRulesComposite{}, 0, err
+		return []PolicyRulesComposite{}, err
 	}
 
 	if len(rules) != len(names) {
-		return []PolicyRulesComposite{}, 0, fmt.Errorf("some rules were not found: %d != %d", len(rules), len(names))
+		return []PolicyRulesComposite{}, fmt.Errorf("some rules were not found: %d != %d", len(rules), len(names))
 	}
 
 	for _, rule := range rules {
@@ -235,7 +235,7 @@ func (c *Client) ListCompositePoliciesByNameAndType(ctx context.Context, policyT
 		policiesFull = append(policiesFull, policy)
 	}
 
-	return policiesFull, http.StatusOK, nil
+	return policiesFull, nil
 }
 
 func (c *Client) createCompositePolicyURL() string {
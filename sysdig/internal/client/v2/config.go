@@ -1,9 +1,19 @@
 package v2
 
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"time"
+)
+
 type config struct {
 	url                   string
 	token                 string
 	insecure              bool
+	rootCAs               *x509.CertPool
+	clientCertificates    []tls.Certificate
+	proxyURL              *url.URL
 	extraHeaders          map[string]string
 	ibmInstanceID         string
 	ibmAPIKey             string
@@ -12,6 +22,21 @@ type config struct {
 	sysdigTeamID          *int
 	product               string
 	secureSkipPolicyV2Msg bool
+	requestsPerSecond     float64
+	maxConcurrentRequests int
+	disableBodyLogging    bool
+	readCache             bool
+	retryMax              *int
+	retryWaitMin          time.Duration
+	retryWaitMax          time.Duration
+	retryableStatusCodes  []int
+	idempotentRetriesOnly bool
+	tokenURL              string
+	clientID              string
+	clientSecret          string
+	tokenScope            string
+	tokenFile             string
+	tokenCommand          string
 }
 
 type Product string
@@ -35,12 +60,63 @@ func WithToken(token string) ClientOption {
 	}
 }
 
+// WithClientCredentials authenticates the client with short-lived tokens obtained from
+// tokenURL with the OAuth client credentials grant, instead of a static API token.
+func WithClientCredentials(tokenURL, clientID, clientSecret, scope string) ClientOption {
+	return func(c *config) {
+		c.tokenURL = tokenURL
+		c.clientID = clientID
+		c.clientSecret = clientSecret
+		c.tokenScope = scope
+	}
+}
+
+// WithTokenFile reads the API token from a file, reloaded whenever it changes.
+func WithTokenFile(path string) ClientOption {
+	return func(c *config) {
+		c.tokenFile = path
+	}
+}
+
+// WithTokenCommand obtains the API token from the output of a shell command.
+func WithTokenCommand(command string) ClientOption {
+	return func(c *config) {
+		c.tokenCommand = command
+	}
+}
+
 func WithInsecure(insecure bool) ClientOption {
 	return func(c *config) {
 		c.insecure = insecure
 	}
 }
 
+// WithRootCAs verifies the API server certificate against the given pool instead of the
+// system trust store, e.g. for on-prem installs using an internal CA.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(c *config) {
+		c.rootCAs = pool
+	}
+}
+
+// WithClientCertificate presents the certificate to the servers requesting mutual TLS.
+func WithClientCertificate(certificate *tls.Certificate) ClientOption {
+	return func(c *config) {
+		c.clientCertificates = nil
+		if certificate != nil {
+			c.clientCertificates = []tls.Certificate{*certificate}
+		}
+	}
+}
+
+// WithProxyURL sends every request of the client through the given proxy,
+// instead of the one configured with the HTTPS_PROXY environment variables.
+func WithProxyURL(proxyURL *url.URL) ClientOption {
+	return func(c *config) {
+		c.proxyURL = proxyURL
+	}
+}
+
 func WithExtraHeaders(headers map[string]string) ClientOption {
 	return func(c *config) {
 		c.extraHeaders = headers
@@ -89,6 +165,64 @@ func WithSecureProduct() ClientOption {
 	}
 }
 
+// WithRequestsPerSecond limits the rate of requests sent by the client, zero means unlimited.
+func WithRequestsPerSecond(requestsPerSecond float64) ClientOption {
+	return func(c *config) {
+		c.requestsPerSecond = requestsPerSecond
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of in-flight requests of the client, zero means unlimited.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) ClientOption {
+	return func(c *config) {
+		c.maxConcurrentRequests = maxConcurrentRequests
+	}
+}
+
+// WithDisableBodyLogging omits the request and response bodies from the DEBUG logs.
+func WithDisableBodyLogging(disable bool) ClientOption {
+	return func(c *config) {
+		c.disableBodyLogging = disable
+	}
+}
+
+// WithReadCache keeps the responses of the list endpoints until the next write issued by the client.
+func WithReadCache(enabled bool) ClientOption {
+	return func(c *config) {
+		c.readCache = enabled
+	}
+}
+
+// WithRetryMax sets how many times a failed request is retried, zero disables the retries.
+func WithRetryMax(retryMax int) ClientOption {
+	return func(c *config) {
+		c.retryMax = &retryMax
+	}
+}
+
+// WithRetryWait bounds the exponential backoff between retries, zero keeps the default bound.
+func WithRetryWait(waitMin, waitMax time.Duration) ClientOption {
+	return func(c *config) {
+		c.retryWaitMin = waitMin
+		c.retryWaitMax = waitMax
+	}
+}
+
+// WithRetryableStatusCodes replaces the response status codes which are retried, nil keeps the defaults.
+func WithRetryableStatusCodes(codes []int) ClientOption {
+	return func(c *config) {
+		c.retryableStatusCodes = codes
+	}
+}
+
+// WithIdempotentRetriesOnly stops retrying the POST and PATCH requests, except when throttled,
+// as the API may have processed them before failing.
+func WithIdempotentRetriesOnly(enabled bool) ClientOption {
+	return func(c *config) {
+		c.idempotentRetriesOnly = enabled
+	}
+}
+
 func configure(opts ...ClientOption) *config {
 	cfg := &config{}
 	for _, opt := range opts {
@@ -2,12 +2,11 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrCustomRoleNotFound = errors.New("custom role not found")
+var ErrCustomRoleNotFound = fmt.Errorf("custom role %w", ErrNotFound)
 
 const (
 	customRolesPath = "%s/api/roles"
@@ -98,9 +97,6 @@ func (c *Client) GetCustomRoleByID(ctx context.Context, id int) (customRole *Cus
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		if response.StatusCode == http.StatusNotFound {
-			return nil, ErrCustomRoleNotFound
-		}
 		return nil, c.ErrorFromResponse(response)
 	}
 
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -12,10 +13,39 @@ const (
 )
 
 type DashboardInterface interface {
+	ListDashboards(ctx context.Context) ([]Dashboard, error)
 	GetDashboardByID(ctx context.Context, ID int) (*Dashboard, error)
 	CreateDashboard(ctx context.Context, dashboard *Dashboard) (*Dashboard, error)
 	UpdateDashboard(ctx context.Context, dashboard *Dashboard) (*Dashboard, error)
 	DeleteDashboard(ctx context.Context, ID int) error
+	GetDashboardJSONByID(ctx context.Context, ID int) (map[string]any, error)
+	CreateDashboardJSON(ctx context.Context, dashboard map[string]any) (map[string]any, error)
+	UpdateDashboardJSON(ctx context.Context, ID int, dashboard map[string]any) (map[string]any, error)
+}
+
+// ListDashboards returns the dashboards of the current team. The panels are not
+// filled in the listing, use GetDashboardByID to get a complete dashboard.
+func (c *Client) ListDashboards(ctx context.Context) (dashboards []Dashboard, err error) {
+	response, err := c.requester.Request(ctx, http.MethodGet, c.getDashboardsURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, c.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardListWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Dashboards, nil
 }
 
 func (c *Client) GetDashboardByID(ctx context.Context, ID int) (dashboard *Dashboard, err error) {
@@ -115,6 +145,55 @@ func (c *Client) DeleteDashboard(ctx context.Context, ID int) (err error) {
 	return nil
 }
 
+// GetDashboardJSONByID returns a dashboard as the JSON object of the API, with the fields
+// Dashboard doesn't model.
+func (c *Client) GetDashboardJSONByID(ctx context.Context, ID int) (map[string]any, error) {
+	return c.requestDashboardJSON(ctx, http.MethodGet, c.getDashboardURL(ID), nil)
+}
+
+// CreateDashboardJSON creates a dashboard from its JSON object, sent as it is.
+func (c *Client) CreateDashboardJSON(ctx context.Context, dashboard map[string]any) (map[string]any, error) {
+	return c.requestDashboardJSON(ctx, http.MethodPost, c.getDashboardsURL(), dashboard)
+}
+
+// UpdateDashboardJSON updates a dashboard from its JSON object, sent as it is.
+func (c *Client) UpdateDashboardJSON(ctx context.Context, ID int, dashboard map[string]any) (map[string]any, error) {
+	return c.requestDashboardJSON(ctx, http.MethodPut, c.getDashboardURL(ID), dashboard)
+}
+
+func (c *Client) requestDashboardJSON(ctx context.Context, method string, url string, dashboard map[string]any) (result map[string]any, err error) {
+	var payload io.Reader
+	if dashboard != nil {
+		payload, err = Marshal(map[string]any{"dashboard": dashboard})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := c.requester.Request(ctx, method, url, payload)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, c.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[struct {
+		Dashboard map[string]any `json:"dashboard"`
+	}](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Dashboard, nil
+}
+
 func (c *Client) getDashboardsURL() string {
 	return fmt.Sprintf(dashboardsPath, c.config.url)
 }
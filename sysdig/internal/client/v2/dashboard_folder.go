@@ -0,0 +1,125 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	dashboardFoldersPath = "%s/api/v3/dashboards/folders"
+	dashboardFolderPath  = "%s/api/v3/dashboards/folders/%d"
+)
+
+type DashboardFolderInterface interface {
+	Base
+	GetDashboardFolderByID(ctx context.Context, id int) (*DashboardFolder, error)
+	CreateDashboardFolder(ctx context.Context, folder *DashboardFolder) (*DashboardFolder, error)
+	UpdateDashboardFolder(ctx context.Context, folder *DashboardFolder) (*DashboardFolder, error)
+	DeleteDashboardFolder(ctx context.Context, id int) error
+}
+
+func (c *Client) GetDashboardFolderByID(ctx context.Context, id int) (folder *DashboardFolder, err error) {
+	response, err := c.requester.Request(ctx, http.MethodGet, c.getDashboardFolderURL(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, c.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardFolderWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Folder, nil
+}
+
+func (c *Client) CreateDashboardFolder(ctx context.Context, folder *DashboardFolder) (createdFolder *DashboardFolder, err error) {
+	payload, err := Marshal(dashboardFolderWrapper{Folder: folder})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.requester.Request(ctx, http.MethodPost, c.getDashboardFoldersURL(), payload)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, c.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardFolderWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Folder, nil
+}
+
+func (c *Client) UpdateDashboardFolder(ctx context.Context, folder *DashboardFolder) (updatedFolder *DashboardFolder, err error) {
+	payload, err := Marshal(dashboardFolderWrapper{Folder: folder})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.requester.Request(ctx, http.MethodPut, c.getDashboardFolderURL(folder.ID), payload)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, c.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardFolderWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Folder, nil
+}
+
+func (c *Client) DeleteDashboardFolder(ctx context.Context, id int) (err error) {
+	response, err := c.requester.Request(ctx, http.MethodDelete, c.getDashboardFolderURL(id), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
+		return c.ErrorFromResponse(response)
+	}
+
+	return nil
+}
+
+func (c *Client) getDashboardFoldersURL() string {
+	return fmt.Sprintf(dashboardFoldersPath, c.config.url)
+}
+
+func (c *Client) getDashboardFolderURL(id int) string {
+	return fmt.Sprintf(dashboardFolderPath, c.config.url, id)
+}
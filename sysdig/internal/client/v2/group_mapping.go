@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrGroupMappingNotFound = errors.New("group mapping not found")
-
 const (
 	createGroupMappingPath = "%s/api/groupmappings"
 	updateGroupMappingPath = "%s/api/groupmappings/%d"
@@ -99,9 +96,6 @@ func (c *Client) GetGroupMapping(ctx context.Context, id int) (mapping *GroupMap
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrGroupMappingNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrGroupMappingConfigNotFound = errors.New("group mapping configuration not found")
-
 const (
 	groupMappingConfigPath = "%s/api/groupmappings/settings"
 )
@@ -53,9 +50,6 @@ func (c *Client) GetGroupMappingConfig(ctx context.Context) (mapping *GroupMappi
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrGroupMappingConfigNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
@@ -52,6 +52,7 @@ type IBMRequest struct {
 
 	teamIDLock *sync.Mutex
 	teamID     *int
+	teamNames  teamNameCache
 }
 
 type IAMTokenResponse struct {
@@ -59,7 +60,7 @@ type IAMTokenResponse struct {
 	Expiration  int64  `json:"expiration"`
 }
 
-func (ir *IBMRequest) getIBMIAMToken() (token IBMAccessToken, err error) {
+func (ir *IBMRequest) getIBMIAMToken(ctx context.Context) (token IBMAccessToken, err error) {
 	ir.tokenLock.Lock()
 	defer ir.tokenLock.Unlock()
 
@@ -72,7 +73,7 @@ func (ir *IBMRequest) getIBMIAMToken() (token IBMAccessToken, err error) {
 	data.Set(ibmAPIKeyFormValue, ir.config.ibmAPIKey)
 	identityURL := fmt.Sprintf("%s%s", ir.config.ibmIamURL, ibmIAMPath)
 
-	r, err := http.NewRequest(http.MethodPost, identityURL, strings.NewReader(data.Encode()))
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, identityURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return "", err
 	}
@@ -89,6 +90,10 @@ func (ir *IBMRequest) getIBMIAMToken() (token IBMAccessToken, err error) {
 		}
 	}()
 
+	if response.StatusCode != http.StatusOK {
+		return "", errorFromResponse(response)
+	}
+
 	iamToken, err := Unmarshal[IAMTokenResponse](response.Body)
 	if err != nil {
 		return "", err
@@ -100,41 +105,28 @@ func (ir *IBMRequest) getIBMIAMToken() (token IBMAccessToken, err error) {
 	return ir.token, nil
 }
 
-func (ir *IBMRequest) getTeamIDByName(ctx context.Context, name string, token IBMAccessToken) (teamID int, err error) {
-	r, err := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("%s%s%s", ir.config.url, getTeamByNamePath, name),
-		nil,
-	)
-	if err != nil {
-		return -1, err
-	}
-
-	r = r.WithContext(ctx)
-	r.Header.Set(ibmInstanceIDHeader, ir.config.ibmInstanceID)
-	r.Header.Set(AuthorizationHeader, fmt.Sprintf("Bearer %s", token))
-	r.Header.Set(SysdigProductHeader, ir.config.product)
-	r.Header.Set(ibmProductHeader, ir.config.product)
-
-	response, err := request(ir.httpClient, ir.config, r)
-	if err != nil {
-		return -1, err
+func (ir *IBMRequest) headers(token IBMAccessToken) map[string]string {
+	return map[string]string{
+		ibmInstanceIDHeader: ir.config.ibmInstanceID,
+		AuthorizationHeader: fmt.Sprintf("Bearer %s", token),
+		SysdigProductHeader: ir.config.product,
+		ibmProductHeader:    ir.config.product,
 	}
-	defer func() {
-		if dErr := response.Body.Close(); dErr != nil {
-			err = fmt.Errorf("unable to close response body: %w", dErr)
-		}
-	}()
+}
 
-	wrapper, err := Unmarshal[teamWrapper](response.Body)
+func (ir *IBMRequest) getTeamIDByName(ctx context.Context, name string) (int, error) {
+	token, err := ir.getIBMIAMToken(ctx)
 	if err != nil {
 		return -1, err
 	}
-
-	return wrapper.Team.ID, nil
+	return getTeamIDByName(ctx, ir.config, ir.httpClient, name, ir.headers(token))
 }
 
 func (ir *IBMRequest) CurrentTeamID(ctx context.Context) (int, error) {
+	if teamID, ok, err := ir.teamNames.overrideTeamID(ctx, ir.getTeamIDByName); ok || err != nil {
+		return teamID, err
+	}
+
 	ir.teamIDLock.Lock()
 	defer ir.teamIDLock.Unlock()
 
@@ -142,13 +134,13 @@ func (ir *IBMRequest) CurrentTeamID(ctx context.Context) (int, error) {
 		return *ir.teamID, nil
 	}
 
-	token, err := ir.getIBMIAMToken()
+	token, err := ir.getIBMIAMToken(ctx)
 	if err != nil {
 		return -1, err
 	}
 
 	if ir.config.sysdigTeamName != "" {
-		teamID, err := ir.getTeamIDByName(ctx, ir.config.sysdigTeamName, token)
+		teamID, err := getTeamIDByName(ctx, ir.config, ir.httpClient, ir.config.sysdigTeamName, ir.headers(token))
 		if err != nil {
 			return -1, err
 		}
@@ -158,12 +150,7 @@ func (ir *IBMRequest) CurrentTeamID(ctx context.Context) (int, error) {
 	}
 
 	// use default current team
-	user, err := getMe(ctx, ir.config, ir.httpClient, map[string]string{
-		ibmInstanceIDHeader: ir.config.ibmInstanceID,
-		AuthorizationHeader: fmt.Sprintf("Bearer %s", token),
-		SysdigProductHeader: ir.config.product,
-		ibmProductHeader:    ir.config.product,
-	})
+	user, err := getMe(ctx, ir.config, ir.httpClient, ir.headers(token))
 	if err != nil {
 		return -1, err
 	}
@@ -183,7 +170,7 @@ func (ir *IBMRequest) Request(ctx context.Context, method string, url string, pa
 		return nil, err
 	}
 
-	token, err := ir.getIBMIAMToken()
+	token, err := ir.getIBMIAMToken(ctx)
 	if err != nil {
 		return nil, err
 	}
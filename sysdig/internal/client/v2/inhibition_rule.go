@@ -2,7 +2,6 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
@@ -12,8 +11,6 @@ const (
 	inhibitionRulePath  = "%s/monitor/alerts/v1/inhibition-rules/%d"
 )
 
-var ErrInhibitionRuleNotFound = errors.New("inhibition rule not found")
-
 type InhibitionRuleInterface interface {
 	Base
 	GetInhibitionRuleByID(ctx context.Context, id int) (InhibitionRule, error)
@@ -33,9 +30,6 @@ func (c *Client) GetInhibitionRuleByID(ctx context.Context, id int) (rule Inhibi
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return InhibitionRule{}, ErrInhibitionRuleNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return InhibitionRule{}, c.ErrorFromResponse(response)
 	}
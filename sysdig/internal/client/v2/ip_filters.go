@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrIPFilterNotFound = errors.New("IP filter not found")
-
 const (
 	ipFiltersPath = "%s/platform/v1/ip-filters"
 	ipFilterPath  = "%s/platform/v1/ip-filters/%d"
@@ -151,6 +151,15 @@ type NotificationChannelOptions struct {
 	IbmFunctionType          string                                     `json:"ibmFunctionType,omitempty"`          // Type: ibm event function
 	CustomData               map[string]any                             `json:"customData,omitempty"`               // Type: ibm function, Webhook
 	TemplateConfiguration    []NotificationChannelTemplateConfiguration `json:"templateConfiguration,omitempty"`    // Type: slack, ms teams
+	Project                  string                                     `json:"project,omitempty"`                  // Type: jira
+	IssueType                string                                     `json:"issueType,omitempty"`                // Type: jira
+	User                     string                                     `json:"user,omitempty"`                     // Type: jira, service now
+	APIToken                 string                                     `json:"apiToken,omitempty"`                 // Type: jira
+	Assignee                 string                                     `json:"assignee,omitempty"`                 // Type: jira
+	Labels                   []string                                   `json:"labels,omitempty"`                   // Type: jira
+	Password                 string                                     `json:"password,omitempty"`                 // Type: service now
+	RoomID                   string                                     `json:"roomId,omitempty"`                   // Type: webex
+	AccessToken              string                                     `json:"accessToken,omitempty"`              // Type: webex
 
 	NotifyOnOk           bool `json:"notifyOnOk"`
 	NotifyOnResolve      bool `json:"notifyOnResolve"`
@@ -708,6 +717,22 @@ type AlertV2Common struct {
 	Labels                        map[string]any                `json:"labels,omitempty"`
 }
 
+// AlertV2Any is an alert of any type, whose configuration is kept as it was read so that
+// the common fields can be updated without knowing the type of the alert.
+type AlertV2Any struct {
+	AlertV2Common
+	Config                                   json.RawMessage `json:"config"`
+	UnreportedAlertNotificationsRetentionSec *int            `json:"unreportedAlertNotificationsRetentionSec,omitempty"`
+}
+
+type alertV2AnyWrapper struct {
+	Alert AlertV2Any `json:"alert"`
+}
+
+type alertsV2Wrapper struct {
+	Alerts []AlertV2Common `json:"alerts"`
+}
+
 type AlertV2ConfigPrometheus struct {
 	Query            string `json:"query"`
 	KeepFiringForSec *int   `json:"keepFiringForSec,omitempty"`
@@ -850,6 +875,8 @@ type AlertV2ConfigChange struct {
 
 	ShorterRangeSec int `json:"shorterRangeSec"`
 	LongerRangeSec  int `json:"longerRangeSec"`
+
+	NoDataBehaviour string `json:"noDataBehaviour"`
 }
 
 type AlertV2ConfigFormBasedPrometheus struct {
@@ -901,6 +928,30 @@ type alertV2GroupOutlierWrapper struct {
 	Alert AlertV2GroupOutlier `json:"alert"`
 }
 
+type AlertV2ConfigAnomaly struct {
+	ScopedSegmentedConfig
+
+	Sensitivity string `json:"sensitivity"`
+	Direction   string `json:"direction"`
+
+	GroupAggregation string                  `json:"groupAggregation"`
+	TimeAggregation  string                  `json:"timeAggregation"`
+	Metric           AlertMetricDescriptorV2 `json:"metric"`
+	NoDataBehaviour  string                  `json:"noDataBehaviour"`
+
+	ObservationWindow int `json:"observationWindow"`
+}
+
+type AlertV2Anomaly struct {
+	AlertV2Common
+	Config                                   AlertV2ConfigAnomaly `json:"config"`
+	UnreportedAlertNotificationsRetentionSec *int                 `json:"unreportedAlertNotificationsRetentionSec"`
+}
+
+type alertV2AnomalyWrapper struct {
+	Alert AlertV2Anomaly `json:"alert"`
+}
+
 type AlertV2Change struct {
 	AlertV2Common
 	Config                                   AlertV2ConfigChange `json:"config"`
@@ -215,7 +215,7 @@ func NewPromqlQuery(query string, parentPanel *Panels, displayInfo DisplayInfo)
 		ParentPanel: parentPanel,
 	}
 
-	if parentPanel.Type == "advancedNumber" {
+	if parentPanel.Type == PanelTypeNumber || parentPanel.Type == PanelTypeGauge {
 		newQuery.DisplayInfo.Color = "mixed"
 		newQuery.DisplayInfo.LineWidth = 2
 	}
@@ -312,6 +312,11 @@ type Panels struct {
 	PanelTitleVisible      bool                 `json:"panelTitleVisible"`
 	TextAutosized          bool                 `json:"textAutosized"`
 	TransparentBackground  bool                 `json:"transparentBackground"`
+	TableSettings          *TableSettings       `json:"tableSettings,omitempty"`
+	ToplistSettings        *ToplistSettings     `json:"toplistSettings,omitempty"`
+	HistogramSettings      *HistogramSettings   `json:"histogramSettings,omitempty"`
+	GaugeSettings          *GaugeSettings       `json:"gaugeSettings,omitempty"`
+	BarChartSettings       *BarChartSettings    `json:"barChartSettings,omitempty"`
 	Type                   PanelType            `json:"type"`
 	// Just a helper to the client, the actual field is in Dashboard
 	Layout *Layout `json:"-"`
@@ -323,11 +328,23 @@ const (
 	PanelTypeTimechart PanelType = "advancedTimechart"
 	PanelTypeNumber    PanelType = "advancedNumber"
 	PanelTypeText      PanelType = "text"
+	PanelTypeTable     PanelType = "advancedTable"
+	PanelTypeToplist   PanelType = "advancedToplist"
+	PanelTypeHistogram PanelType = "advancedHistogram"
+	PanelTypeGauge     PanelType = "advancedGauge"
+	PanelTypeBarChart  PanelType = "advancedBarChart"
 )
 
+// singleQueryPanelTypes are the panel types that display the result of just one query.
+var singleQueryPanelTypes = map[PanelType]string{
+	PanelTypeNumber:    "number",
+	PanelTypeHistogram: "histogram",
+	PanelTypeGauge:     "gauge",
+}
+
 func (p *Panels) AddQueries(queries ...*AdvancedQueries) (*Panels, error) {
-	if p.Type == PanelTypeNumber && len(p.AdvancedQueries)+len(queries) > 1 {
-		return nil, fmt.Errorf("a panel of type 'number' can only contain one query")
+	if name, ok := singleQueryPanelTypes[p.Type]; ok && len(p.AdvancedQueries)+len(queries) > 1 {
+		return nil, fmt.Errorf("a panel of type '%s' can only contain one query", name)
 	}
 
 	maxIndex := 0
@@ -372,6 +389,65 @@ func (p *Panels) WithLayout(xPos, yPos, width, height int) (*Panels, error) {
 	return p, nil
 }
 
+type TableSettings struct {
+	PageSize  int    `json:"pageSize"`
+	SortBy    string `json:"sortBy,omitempty"`
+	SortOrder string `json:"sortOrder"`
+}
+
+func NewTableSettings() *TableSettings {
+	return &TableSettings{
+		PageSize:  10,
+		SortOrder: "desc",
+	}
+}
+
+type ToplistSettings struct {
+	Limit     int    `json:"limit"`
+	SortOrder string `json:"sortOrder"`
+}
+
+func NewToplistSettings() *ToplistSettings {
+	return &ToplistSettings{
+		Limit:     10,
+		SortOrder: "desc",
+	}
+}
+
+type HistogramSettings struct {
+	BucketCount int `json:"bucketCount"`
+}
+
+func NewHistogramSettings() *HistogramSettings {
+	return &HistogramSettings{
+		BucketCount: 10,
+	}
+}
+
+type GaugeSettings struct {
+	MinValue float64 `json:"minValue"`
+	MaxValue float64 `json:"maxValue"`
+}
+
+func NewGaugeSettings() *GaugeSettings {
+	return &GaugeSettings{
+		MinValue: 0,
+		MaxValue: 100,
+	}
+}
+
+type BarChartSettings struct {
+	Orientation string `json:"orientation"`
+	Stacked     bool   `json:"stacked"`
+}
+
+func NewBarChartSettings() *BarChartSettings {
+	return &BarChartSettings{
+		Orientation: "vertical",
+		Stacked:     false,
+	}
+}
+
 type NumberThresholds struct {
 	Base   NumberThresholdBase `json:"base"`
 	Values []any               `json:"values"`
@@ -405,6 +481,8 @@ type ScopeExpressionList struct {
 	Value       []string `json:"value"`
 	Descriptor  any      `json:"descriptor"`
 	IsVariable  bool     `json:"isVariable"`
+	// ValuesQuery is the PromQL query whose results are offered as the values of a variable
+	ValuesQuery string `json:"valuesQuery,omitempty"`
 }
 
 type Dashboard struct {
@@ -434,12 +512,27 @@ type Dashboard struct {
 	ModifiedOnDate          string                 `json:"modifiedOnDate"`
 	TeamSharingOptions      TeamSharingOptions     `json:"teamSharingOptions"`
 	MinInterval             string                 `json:"minInterval"`
+	FolderID                int                    `json:"folderId,omitempty"`
 }
 
 type dashboardWrapper struct {
 	Dashboard *Dashboard `json:"dashboard"`
 }
 
+type dashboardListWrapper struct {
+	Dashboards []Dashboard `json:"dashboards"`
+}
+
+type DashboardFolder struct {
+	ID      int    `json:"id,omitempty"`
+	Version int    `json:"version,omitempty"`
+	Name    string `json:"name"`
+}
+
+type dashboardFolderWrapper struct {
+	Folder *DashboardFolder `json:"folder"`
+}
+
 func (db *Dashboard) AddPanels(panels ...*Panels) {
 	maxPanelID := 0
 	for _, existingPanel := range db.Panels {
@@ -2,7 +2,6 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
@@ -12,12 +11,11 @@ const (
 	getNotificationChannel  = "%s/api/notificationChannels/%d"
 )
 
-var ErrNotificationChannelNotFound = errors.New("notification channel not found")
-
 type NotificationChannelInterface interface {
 	Base
 	GetNotificationChannelByID(ctx context.Context, id int) (NotificationChannel, error)
 	GetNotificationChannelByName(ctx context.Context, name string) (NotificationChannel, error)
+	ListNotificationChannels(ctx context.Context) ([]NotificationChannel, error)
 	CreateNotificationChannel(ctx context.Context, channel NotificationChannel) (NotificationChannel, error)
 	UpdateNotificationChannel(ctx context.Context, channel NotificationChannel) (NotificationChannel, error)
 	DeleteNotificationChannel(ctx context.Context, id int) error
@@ -34,9 +32,6 @@ func (c *Client) GetNotificationChannelByID(ctx context.Context, id int) (nc Not
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return NotificationChannel{}, ErrNotificationChannelNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return NotificationChannel{}, c.ErrorFromResponse(response)
 	}
@@ -54,9 +49,24 @@ func (c *Client) GetNotificationChannelByID(ctx context.Context, id int) (nc Not
 }
 
 func (c *Client) GetNotificationChannelByName(ctx context.Context, name string) (nc NotificationChannel, err error) {
+	channels, err := c.ListNotificationChannels(ctx)
+	if err != nil {
+		return NotificationChannel{}, err
+	}
+
+	for _, channel := range channels {
+		if channel.Name == name {
+			return channel, nil
+		}
+	}
+
+	return NotificationChannel{}, fmt.Errorf("notification channel with name: %s does not exist", name)
+}
+
+func (c *Client) ListNotificationChannels(ctx context.Context) (channels []NotificationChannel, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getNotificationChannelsURL(), nil)
 	if err != nil {
-		return NotificationChannel{}, nil
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -65,21 +75,15 @@ func (c *Client) GetNotificationChannelByName(ctx context.Context, name string)
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return NotificationChannel{}, c.ErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	wrapper, err := Unmarshal[notificationChannelListWrapper](response.Body)
 	if err != nil {
-		return NotificationChannel{}, err
+		return nil, err
 	}
 
-	for _, channel := range wrapper.NotificationChannels {
-		if channel.Name == name {
-			return channel, nil
-		}
-	}
-
-	return NotificationChannel{}, fmt.Errorf("notification channel with name: %s does not exist", name)
+	return wrapper.NotificationChannels, nil
 }
 
 func (c *Client) CreateNotificationChannel(ctx context.Context, channel NotificationChannel) (nc NotificationChannel, err error) {
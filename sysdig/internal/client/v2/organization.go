@@ -14,21 +14,21 @@ const (
 
 type OrganizationSecureInterface interface {
 	Base
-	CreateOrganizationSecure(ctx context.Context, org *OrganizationSecure) (*OrganizationSecure, string, error)
-	GetOrganizationSecure(ctx context.Context, orgID string) (*OrganizationSecure, string, error)
-	DeleteOrganizationSecure(ctx context.Context, orgID string) (string, error)
-	UpdateOrganizationSecure(ctx context.Context, orgID string, org *OrganizationSecure) (*OrganizationSecure, string, error)
+	CreateOrganizationSecure(ctx context.Context, org *OrganizationSecure) (*OrganizationSecure, error)
+	GetOrganizationSecure(ctx context.Context, orgID string) (*OrganizationSecure, error)
+	DeleteOrganizationSecure(ctx context.Context, orgID string) error
+	UpdateOrganizationSecure(ctx context.Context, orgID string, org *OrganizationSecure) (*OrganizationSecure, error)
 }
 
-func (c *Client) CreateOrganizationSecure(ctx context.Context, org *OrganizationSecure) (organization *OrganizationSecure, errString string, err error) {
+func (c *Client) CreateOrganizationSecure(ctx context.Context, org *OrganizationSecure) (organization *OrganizationSecure, err error) {
 	payload, err := c.marshalCloudauthProto(org)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPost, c.organizationsURL(), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -37,22 +37,21 @@ func (c *Client) CreateOrganizationSecure(ctx context.Context, org *Organization
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusAccepted {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	organization = &OrganizationSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, organization)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return organization, "", nil
+	return organization, nil
 }
 
-func (c *Client) GetOrganizationSecure(ctx context.Context, orgID string) (organization *OrganizationSecure, errString string, err error) {
+func (c *Client) GetOrganizationSecure(ctx context.Context, orgID string) (organization *OrganizationSecure, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.organizationURL(orgID), nil)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -61,22 +60,21 @@ func (c *Client) GetOrganizationSecure(ctx context.Context, orgID string) (organ
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	organization = &OrganizationSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, organization)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return organization, "", nil
+	return organization, nil
 }
 
-func (c *Client) DeleteOrganizationSecure(ctx context.Context, orgID string) (errString string, err error) {
+func (c *Client) DeleteOrganizationSecure(ctx context.Context, orgID string) (err error) {
 	response, err := c.requester.Request(ctx, http.MethodDelete, c.organizationURL(orgID), nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -85,21 +83,20 @@ func (c *Client) DeleteOrganizationSecure(ctx context.Context, orgID string) (er
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return errStatus, err
+		return c.ErrorFromResponse(response)
 	}
-	return "", nil
+	return nil
 }
 
-func (c *Client) UpdateOrganizationSecure(ctx context.Context, orgID string, org *OrganizationSecure) (organization *OrganizationSecure, errString string, err error) {
+func (c *Client) UpdateOrganizationSecure(ctx context.Context, orgID string, org *OrganizationSecure) (organization *OrganizationSecure, err error) {
 	payload, err := c.marshalCloudauthProto(org)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPut, c.organizationURL(orgID), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -108,16 +105,15 @@ func (c *Client) UpdateOrganizationSecure(ctx context.Context, orgID string, org
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusAccepted {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	organization = &OrganizationSecure{}
 	err = c.unmarshalCloudauthProto(response.Body, organization)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return organization, "", nil
+	return organization, nil
 }
 
 func (c *Client) organizationsURL() string {
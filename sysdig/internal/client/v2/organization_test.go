@@ -67,7 +67,7 @@ func TestUpdateOrganizationSecureUsesCamelCase(t *testing.T) {
 		},
 	}
 
-	_, _, err := c.UpdateOrganizationSecure(context.Background(), "e69f12fd-934d-43cc-8b8c-2964aba20003", org)
+	_, err := c.UpdateOrganizationSecure(context.Background(), "e69f12fd-934d-43cc-8b8c-2964aba20003", org)
 	if err != nil {
 		t.Fatalf("UpdateOrganizationSecure failed: %v", err)
 	}
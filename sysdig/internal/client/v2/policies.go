@@ -20,8 +20,8 @@ type PolicyInterface interface {
 	CreatePolicy(ctx context.Context, policy Policy) (Policy, error)
 	DeletePolicy(ctx context.Context, policyID int) error
 	UpdatePolicy(ctx context.Context, policy Policy) (Policy, error)
-	GetPolicyByID(ctx context.Context, policyID int) (Policy, int, error)
-	GetPolicies(ctx context.Context) ([]Policy, int, error)
+	GetPolicyByID(ctx context.Context, policyID int) (Policy, error)
+	GetPolicies(ctx context.Context) ([]Policy, error)
 	SendPoliciesToAgents(ctx context.Context) error
 }
 
@@ -89,10 +89,10 @@ func (c *Client) UpdatePolicy(ctx context.Context, policy Policy) (updatedPolicy
 	return Unmarshal[Policy](response.Body)
 }
 
-func (c *Client) GetPolicyByID(ctx context.Context, policyID int) (policy Policy, statusCode int, err error) {
+func (c *Client) GetPolicyByID(ctx context.Context, policyID int) (policy Policy, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getPolicyURL(policyID), nil)
 	if err != nil {
-		return Policy{}, 0, err
+		return Policy{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -101,21 +101,21 @@ func (c *Client) GetPolicyByID(ctx context.Context, policyID int) (policy Policy
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return Policy{}, response.StatusCode, c.ErrorFromResponse(response)
+		return Policy{}, c.ErrorFromResponse(response)
 	}
 
 	policy, err = Unmarshal[Policy](response.Body)
 	if err != nil {
-		return Policy{}, 0, err
+		return Policy{}, err
 	}
 
-	return policy, http.StatusOK, nil
+	return policy, nil
 }
 
-func (c *Client) GetPolicies(ctx context.Context) (policies []Policy, statusCode int, err error) {
+func (c *Client) GetPolicies(ctx context.Context) (policies []Policy, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getPoliciesURL(), nil)
 	if err != nil {
-		return []Policy{}, 0, err
+		return []Policy{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -124,15 +124,15 @@ func (c *Client) GetPolicies(ctx context.Context) (policies []Policy, statusCode
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return []Policy{}, response.StatusCode, c.ErrorFromResponse(response)
+		return []Policy{}, c.ErrorFromResponse(response)
 	}
 
 	policies, err = Unmarshal[[]Policy](response.Body)
 	if err != nil {
-		return []Policy{}, 0, err
+		return []Policy{}, err
 	}
 
-	return policies, http.StatusOK, nil
+	return policies, nil
 }
 
 func (c *Client) SendPoliciesToAgents(ctx context.Context) (err error) {
@@ -149,7 +149,7 @@ func (c *Client) SendPoliciesToAgents(ctx context.Context) (err error) {
 		}()
 
 		if response.StatusCode != http.StatusOK {
-			return fmt.Errorf("unexpected response when sending policies to agents: %s", response.Status)
+			return fmt.Errorf("unexpected response when sending policies to agents: %w", c.ErrorFromResponse(response))
 		}
 	}
 	return nil
@@ -15,20 +15,20 @@ const (
 
 type PostureAcceptRiskInterface interface {
 	Base
-	SaveAcceptPostureRisk(ctx context.Context, p *AccepetPostureRiskRequest) (*AcceptPostureRiskResponse, string, error)
-	GetAcceptancePostureRisk(ctx context.Context, id string) (*AcceptPostureRiskResponse, string, error)
+	SaveAcceptPostureRisk(ctx context.Context, p *AccepetPostureRiskRequest) (*AcceptPostureRiskResponse, error)
+	GetAcceptancePostureRisk(ctx context.Context, id string) (*AcceptPostureRiskResponse, error)
 	DeleteAcceptancePostureRisk(ctx context.Context, p *DeleteAcceptPostureRisk) error
-	UpdateAcceptancePostureRisk(ctx context.Context, p *UpdateAccepetPostureRiskRequest) (*AcceptPostureRisk, string, error)
+	UpdateAcceptancePostureRisk(ctx context.Context, p *UpdateAccepetPostureRiskRequest) (*AcceptPostureRisk, error)
 }
 
-func (c *Client) SaveAcceptPostureRisk(ctx context.Context, p *AccepetPostureRiskRequest) (risk *AcceptPostureRiskResponse, errString string, err error) {
+func (c *Client) SaveAcceptPostureRisk(ctx context.Context, p *AccepetPostureRiskRequest) (risk *AcceptPostureRiskResponse, err error) {
 	payload, err := Marshal(p)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	response, err := c.requester.Request(ctx, http.MethodPost, c.getPostureControlURL(acceptPostureRiskCreatePath), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	defer func() {
@@ -37,21 +37,20 @@ func (c *Client) SaveAcceptPostureRisk(ctx context.Context, p *AccepetPostureRis
 		}
 	}()
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 	resp, err := Unmarshal[AcceptPostureRiskResponse](response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	return &resp, "", nil
+	return &resp, nil
 }
 
-func (c *Client) GetAcceptancePostureRisk(ctx context.Context, id string) (risk *AcceptPostureRiskResponse, errString string, err error) {
+func (c *Client) GetAcceptancePostureRisk(ctx context.Context, id string) (risk *AcceptPostureRiskResponse, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, fmt.Sprintf(acceptPostureRiskGetPath, c.config.url, id), nil)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -60,15 +59,14 @@ func (c *Client) GetAcceptancePostureRisk(ctx context.Context, id string) (risk
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	wrapper, err := Unmarshal[AcceptPostureRiskResponse](response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return &wrapper, "", nil
+	return &wrapper, nil
 }
 
 func (c *Client) DeleteAcceptancePostureRisk(ctx context.Context, p *DeleteAcceptPostureRisk) (err error) {
@@ -94,14 +92,14 @@ func (c *Client) DeleteAcceptancePostureRisk(ctx context.Context, p *DeleteAccep
 	return nil
 }
 
-func (c *Client) UpdateAcceptancePostureRisk(ctx context.Context, p *UpdateAccepetPostureRiskRequest) (risk *AcceptPostureRisk, errString string, err error) {
+func (c *Client) UpdateAcceptancePostureRisk(ctx context.Context, p *UpdateAccepetPostureRiskRequest) (risk *AcceptPostureRisk, err error) {
 	payload, err := Marshal(p)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	response, err := c.requester.Request(ctx, http.MethodPatch, fmt.Sprintf(acceptPostureRiskUpdate, c.config.url, p.AcceptanceID), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -109,13 +107,12 @@ func (c *Client) UpdateAcceptancePostureRisk(ctx context.Context, p *UpdateAccep
 		}
 	}()
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 	resp, err := Unmarshal[AcceptPostureRiskResponse](response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	return &resp.Data, "", nil
+	return &resp.Data, nil
 }
@@ -14,19 +14,19 @@ const (
 
 type PostureControlInterface interface {
 	Base
-	CreateOrUpdatePostureControl(ctx context.Context, p *SaveControlRequest) (*PostureControl, string, error)
+	CreateOrUpdatePostureControl(ctx context.Context, p *SaveControlRequest) (*PostureControl, error)
 	GetPostureControlByID(ctx context.Context, id int64) (*PostureControl, error)
 	DeletePostureControlByID(ctx context.Context, id int64) error
 }
 
-func (c *Client) CreateOrUpdatePostureControl(ctx context.Context, p *SaveControlRequest) (control *PostureControl, status string, err error) {
+func (c *Client) CreateOrUpdatePostureControl(ctx context.Context, p *SaveControlRequest) (control *PostureControl, err error) {
 	payload, err := Marshal(p)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	response, err := c.requester.Request(ctx, http.MethodPost, c.getPostureControlURL(postureControlSavePath), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -34,14 +34,13 @@ func (c *Client) CreateOrUpdatePostureControl(ctx context.Context, p *SaveContro
 		}
 	}()
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 	resp, err := Unmarshal[SaveControlResponse](response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return &resp.Data, "", nil
+	return &resp.Data, nil
 }
 
 func (c *Client) GetPostureControlByID(ctx context.Context, id int64) (control *PostureControl, err error) {
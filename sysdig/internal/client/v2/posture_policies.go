@@ -16,7 +16,7 @@ const (
 type PosturePolicyInterface interface {
 	Base
 	ListPosturePolicies(ctx context.Context) ([]PosturePolicy, error)
-	CreateOrUpdatePosturePolicy(ctx context.Context, p *CreatePosturePolicy) (*FullPosturePolicy, string, error)
+	CreateOrUpdatePosturePolicy(ctx context.Context, p *CreatePosturePolicy) (*FullPosturePolicy, error)
 	GetPosturePolicyByID(ctx context.Context, id int64) (*FullPosturePolicy, error)
 	DeletePosturePolicy(ctx context.Context, id int64) error
 }
@@ -44,14 +44,14 @@ func (c *Client) ListPosturePolicies(ctx context.Context) (policies []PosturePol
 	return resp.Data, nil
 }
 
-func (c *Client) CreateOrUpdatePosturePolicy(ctx context.Context, p *CreatePosturePolicy) (policy *FullPosturePolicy, errString string, err error) {
+func (c *Client) CreateOrUpdatePosturePolicy(ctx context.Context, p *CreatePosturePolicy) (policy *FullPosturePolicy, err error) {
 	payload, err := Marshal(p)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	response, err := c.requester.Request(ctx, http.MethodPost, c.getPosturePolicyURL(posturePolicyCreatePath), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -59,14 +59,13 @@ func (c *Client) CreateOrUpdatePosturePolicy(ctx context.Context, p *CreatePostu
 		}
 	}()
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 	resp, err := Unmarshal[FullPosturePolicyResponse](response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	return &resp.Data, "", nil
+	return &resp.Data, nil
 }
 
 func (c *Client) GetPosturePolicyByID(ctx context.Context, id int64) (policy *FullPosturePolicy, err error) {
@@ -13,24 +13,24 @@ const (
 
 type PostureZoneInterface interface {
 	Base
-	CreateOrUpdatePostureZone(ctx context.Context, z *PostureZoneRequest) (*PostureZone, string, error)
+	CreateOrUpdatePostureZone(ctx context.Context, z *PostureZoneRequest) (*PostureZone, error)
 	GetPostureZoneByID(ctx context.Context, id int) (*PostureZone, error)
 	DeletePostureZone(ctx context.Context, id int) error
 }
 
-func (c *Client) CreateOrUpdatePostureZone(ctx context.Context, r *PostureZoneRequest) (zone *PostureZone, errStatus string, err error) {
+func (c *Client) CreateOrUpdatePostureZone(ctx context.Context, r *PostureZoneRequest) (zone *PostureZone, err error) {
 	if r.ID == "" {
 		r.ID = "0"
 	}
 
 	payload, err := Marshal(r)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPost, c.createPostureZoneURL(), payload)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -39,16 +39,15 @@ func (c *Client) CreateOrUpdatePostureZone(ctx context.Context, r *PostureZoneRe
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusAccepted {
-		errStatus, err := c.ErrorAndStatusFromResponse(response)
-		return nil, errStatus, err
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	wrapper, err := Unmarshal[PostureZoneResponse](response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	return &wrapper.Data, "", nil
+	return &wrapper.Data, nil
 }
 
 func (c *Client) GetPostureZoneByID(ctx context.Context, id int) (zone *PostureZone, err error) {
@@ -0,0 +1,118 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const RetryAfterHeader = "Retry-After"
+
+// rateLimitedTransport throttles the requests issued by a single product client.
+// It caps the request rate and the number of in-flight requests, and when the API
+// answers 429 with a Retry-After header every request of the client is held back
+// until that moment, not only the one being retried.
+type rateLimitedTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+	slots   chan struct{}
+
+	pauseLock   sync.Mutex
+	pausedUntil time.Time
+}
+
+func newRateLimitedTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrentRequests int) *rateLimitedTransport {
+	t := &rateLimitedTransport{next: next}
+	if requestsPerSecond > 0 {
+		burst := max(1, int(requestsPerSecond))
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	if maxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+	return t
+}
+
+func (t *rateLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	if err := t.waitForPause(ctx); err != nil {
+		return nil, err
+	}
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			defer func() { <-t.slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := t.next.RoundTrip(r)
+	if err == nil && response.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := parseRetryAfter(response.Header.Get(RetryAfterHeader)); ok {
+			t.pause(wait)
+		}
+	}
+	return response, err
+}
+
+func (t *rateLimitedTransport) pause(wait time.Duration) {
+	t.pauseLock.Lock()
+	defer t.pauseLock.Unlock()
+
+	until := time.Now().Add(wait)
+	if until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+}
+
+func (t *rateLimitedTransport) waitForPause(ctx context.Context) error {
+	t.pauseLock.Lock()
+	wait := time.Until(t.pausedUntil)
+	t.pauseLock.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value, either delay seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(0, time.Until(date)), true
+}
@@ -0,0 +1,213 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+)
+
+const redactedValue = "**REDACTED**"
+
+// sensitiveHeaders are masked in the DEBUG dumps, on top of the user provided extra headers.
+var sensitiveHeaders = []string{
+	AuthorizationHeader,
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveFields are the JSON fields and form values masked in the DEBUG dumps, matched
+// case-insensitively. An entry in the form "parent.field" only matches the field when it
+// is nested in an object stored under parent.
+var sensitiveFields = []string{
+	// IBM IAM and OAuth token requests, IAMTokenResponse and oauthTokenResponse
+	ibmAPIKeyFormValue,
+	oauthClientSecretFormValue,
+	"access_token",
+	"refresh_token",
+
+	// NotificationChannelOptions, webhook URLs embed the secret of the integration
+	"apiKey",
+	"apiToken",
+	"accessToken",
+	"routingKey",
+	"serviceKey",
+	"url",
+	"privateChannelUrl",
+	"additionalHeaders",
+
+	// TeamServiceAccount, AgentAccessKey
+	"accessKey",
+
+	// SSOOpenID, cloudauth service principals
+	"clientSecret",
+	"privateKey",
+	"keyValue",
+
+	// CloudAccountCredentialsMonitor
+	"credentials.key",
+
+	// Dashboard
+	"publicToken",
+
+	"password",
+	"secret",
+	"token",
+}
+
+var sensitiveFieldSet = func() map[string]struct{} {
+	set := make(map[string]struct{}, len(sensitiveFields))
+	for _, field := range sensitiveFields {
+		set[strings.ToLower(field)] = struct{}{}
+	}
+	return set
+}()
+
+func isSensitiveField(parent, field string) bool {
+	field = strings.ToLower(field)
+	if _, ok := sensitiveFieldSet[field]; ok {
+		return true
+	}
+	if parent == "" {
+		return false
+	}
+	_, ok := sensitiveFieldSet[strings.ToLower(parent)+"."+field]
+	return ok
+}
+
+// dumpRequest returns the DEBUG representation of an outgoing request with its secrets masked.
+// The request body is left untouched for the actual call.
+func dumpRequest(cfg *config, request *http.Request) ([]byte, error) {
+	clone := request.Clone(request.Context())
+	redactHeaders(cfg, clone.Header)
+
+	if cfg.disableBodyLogging || request.Body == nil || request.Body == http.NoBody {
+		return httputil.DumpRequestOut(clone, false)
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	redacted := redactBody(request.Header.Get(ContentTypeHeader), body)
+	clone.Body = io.NopCloser(bytes.NewReader(redacted))
+	clone.ContentLength = int64(len(redacted))
+	return httputil.DumpRequestOut(clone, true)
+}
+
+// dumpResponse is the response counterpart of dumpRequest.
+func dumpResponse(cfg *config, response *http.Response) ([]byte, error) {
+	clone := *response
+	clone.Header = response.Header.Clone()
+	redactHeaders(cfg, clone.Header)
+
+	if cfg.disableBodyLogging || response.Body == nil || response.Body == http.NoBody {
+		return httputil.DumpResponse(&clone, false)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	redacted := redactBody(response.Header.Get(ContentTypeHeader), body)
+	clone.Body = io.NopCloser(bytes.NewReader(redacted))
+	clone.ContentLength = int64(len(redacted))
+	clone.TransferEncoding = nil
+	return httputil.DumpResponse(&clone, true)
+}
+
+func redactHeaders(cfg *config, header http.Header) {
+	for _, name := range sensitiveHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, redactedValue)
+		}
+	}
+	for name := range cfg.extraHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, redactedValue)
+		}
+	}
+}
+
+// redactBody masks the sensitive fields of a JSON or form encoded body, any other
+// content is returned as is.
+func redactBody(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	if strings.Contains(contentType, ContentTypeFormURLEncoded) {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		for key := range values {
+			if isSensitiveField("", key) {
+				values.Set(key, redactedValue)
+			}
+		}
+		return []byte(values.Encode())
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return body
+	}
+
+	var redacted bytes.Buffer
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactJSON("", data)); err != nil {
+		return body
+	}
+	return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+}
+
+func redactJSON(parent string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if isSensitiveField(parent, key) {
+				v[key] = redactAll(child)
+			} else {
+				v[key] = redactJSON(key, child)
+			}
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = redactJSON(parent, child)
+		}
+	}
+	return value
+}
+
+// redactAll masks every non empty string nested in value.
+func redactAll(value any) any {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return redactedValue
+		}
+	case map[string]any:
+		for key, child := range v {
+			v[key] = redactAll(child)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = redactAll(child)
+		}
+	}
+	return value
+}
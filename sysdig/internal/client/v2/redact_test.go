@@ -0,0 +1,147 @@
+//go:build unit
+
+package v2
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "notification channel",
+			contentType: ContentTypeJSON,
+			body:        `{"notificationChannel":{"name":"slack","options":{"url":"https://hooks.slack.com/services/secret","channel":"#alerts","additionalHeaders":{"X-Token":"secret"}}}}`,
+			want:        `{"notificationChannel":{"name":"slack","options":{"additionalHeaders":{"X-Token":"**REDACTED**"},"channel":"#alerts","url":"**REDACTED**"}}}`,
+		},
+		{
+			name:        "jira channel",
+			contentType: ContentTypeJSON,
+			body:        `{"notificationChannel":{"type":"JIRA","options":{"url":"https://example.atlassian.net","project":"OPS","user":"ops@example.com","apiToken":"JIRA-SECRET"}}}`,
+			want:        `{"notificationChannel":{"options":{"apiToken":"**REDACTED**","project":"OPS","url":"**REDACTED**","user":"ops@example.com"},"type":"JIRA"}}`,
+		},
+		{
+			name:        "webex channel",
+			contentType: ContentTypeJSON,
+			body:        `{"notificationChannel":{"type":"WEBEX","options":{"roomId":"Y2lzY29zcGFyazovL3VzL1JPT00v","accessToken":"WEBEX-SECRET"}}}`,
+			want:        `{"notificationChannel":{"options":{"accessToken":"**REDACTED**","roomId":"Y2lzY29zcGFyazovL3VzL1JPT00v"},"type":"WEBEX"}}`,
+		},
+		{
+			name:        "nested field",
+			contentType: ContentTypeJSON,
+			body:        `{"credentials":{"id":"AKIA","key":"secret"},"key":"label"}`,
+			want:        `{"credentials":{"id":"AKIA","key":"**REDACTED**"},"key":"label"}`,
+		},
+		{
+			name:        "list of objects",
+			contentType: ContentTypeJSON,
+			body:        `{"customerAccessKeys":[{"id":1,"accessKey":"secret"}]}`,
+			want:        `{"customerAccessKeys":[{"accessKey":"**REDACTED**","id":1}]}`,
+		},
+		{
+			name:        "case insensitive",
+			contentType: ContentTypeJSON,
+			body:        `{"ClientSecret":"secret","PublicToken":""}`,
+			want:        `{"ClientSecret":"**REDACTED**","PublicToken":""}`,
+		},
+		{
+			name:        "form encoded",
+			contentType: ContentTypeFormURLEncoded,
+			body:        "apikey=secret&grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey",
+			want:        "apikey=%2A%2AREDACTED%2A%2A&grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey",
+		},
+		{
+			name:        "not json",
+			contentType: "text/plain",
+			body:        "token: plain",
+			want:        "token: plain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := string(redactBody(tt.contentType, []byte(tt.body)))
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDumpRequest(t *testing.T) {
+	t.Parallel()
+
+	body := `{"apiKey":"secret","name":"foo"}`
+	r, err := http.NewRequest(http.MethodPost, "https://example.com/api", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to create request, %v", err)
+	}
+	r.Header.Set(AuthorizationHeader, "Bearer secret")
+	r.Header.Set(ContentTypeHeader, ContentTypeJSON)
+	r.Header.Set("X-Extra", "secret")
+
+	cfg := &config{extraHeaders: map[string]string{"X-Extra": "secret"}}
+	out, err := dumpRequest(cfg, r)
+	if err != nil {
+		t.Fatalf("failed to dump request, %v", err)
+	}
+	if strings.Contains(string(out), "secret") {
+		t.Errorf("dump contains a secret:\n%s", out)
+	}
+	if !strings.Contains(string(out), `"name":"foo"`) {
+		t.Errorf("dump is missing the body:\n%s", out)
+	}
+
+	sent, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("failed to read request body, %v", err)
+	}
+	if string(sent) != body {
+		t.Errorf("request body was modified, got %s", sent)
+	}
+	if r.Header.Get(AuthorizationHeader) != "Bearer secret" {
+		t.Errorf("request headers were modified")
+	}
+}
+
+func TestDumpResponse_DisableBodyLogging(t *testing.T) {
+	t.Parallel()
+
+	body := `{"access_token":"secret"}`
+	response := &http.Response{
+		StatusCode:    http.StatusOK,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Set-Cookie": []string{"session=secret"}},
+		Body:          io.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+	}
+
+	out, err := dumpResponse(&config{disableBodyLogging: true}, response)
+	if err != nil {
+		t.Fatalf("failed to dump response, %v", err)
+	}
+	if strings.Contains(string(out), "secret") {
+		t.Errorf("dump contains a secret:\n%s", out)
+	}
+
+	received, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body, %v", err)
+	}
+	if string(received) != body {
+		t.Errorf("response body was modified, got %s", received)
+	}
+}
@@ -0,0 +1,76 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	defaultRetryMax     = 5
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+type nonIdempotentRequestKey struct{}
+
+// nonIdempotentTransport flags the POST and PATCH requests in their context, so that
+// the retry policy, which only sees the context of failed connections, can tell them apart.
+type nonIdempotentTransport struct {
+	next http.RoundTripper
+}
+
+func (t *nonIdempotentTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		r = r.WithContext(context.WithValue(r.Context(), nonIdempotentRequestKey{}, true))
+	}
+	return t.next.RoundTrip(r)
+}
+
+// retryWaitBounds returns the bounds of the backoff between retries, the default of a bound which
+// is not set being moved to the other one when that one is set beyond it.
+func retryWaitBounds(waitMin, waitMax time.Duration) (time.Duration, time.Duration) {
+	if waitMin == 0 {
+		waitMin = defaultRetryWaitMin
+		if waitMax > 0 {
+			waitMin = min(waitMin, waitMax)
+		}
+	}
+	if waitMax == 0 {
+		waitMax = max(waitMin, defaultRetryWaitMax)
+	}
+	return waitMin, waitMax
+}
+
+// checkRetry decides whether a request is retried. By default connection errors, 409, 429
+// and 5xx responses but 501 are, the status codes can be replaced with WithRetryableStatusCodes.
+func (cfg *config) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if cfg.idempotentRetriesOnly && ctx.Value(nonIdempotentRequestKey{}) != nil {
+		// a throttled request has not been processed, it is safe to send it again
+		return resp != nil && resp.StatusCode == http.StatusTooManyRequests, nil
+	}
+
+	if err != nil || resp == nil {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	if cfg.retryableStatusCodes != nil {
+		return slices.Contains(cfg.retryableStatusCodes, resp.StatusCode), nil
+	}
+
+	// Use default retry logic for 429 and 5xx
+	shouldRetry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if shouldRetry || checkErr != nil {
+		return shouldRetry, checkErr
+	}
+
+	// Additionally retry on 409 Conflict
+	return resp.StatusCode == http.StatusConflict, nil
+}
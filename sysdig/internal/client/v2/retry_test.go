@@ -0,0 +1,101 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	fastRetries := WithRetryWait(time.Millisecond, 2*time.Millisecond)
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		method   string
+		status   int
+		expected int32
+	}{
+		{name: "default conflict", opts: []ClientOption{fastRetries}, method: http.MethodPut, status: http.StatusConflict, expected: 6},
+		{name: "default not found", opts: []ClientOption{fastRetries}, method: http.MethodGet, status: http.StatusNotFound, expected: 1},
+		{name: "no retries", opts: []ClientOption{fastRetries, WithRetryMax(0)}, method: http.MethodGet, status: http.StatusServiceUnavailable, expected: 1},
+		{name: "max retries", opts: []ClientOption{fastRetries, WithRetryMax(2)}, method: http.MethodGet, status: http.StatusServiceUnavailable, expected: 3},
+		{name: "custom codes", opts: []ClientOption{fastRetries, WithRetryMax(2), WithRetryableStatusCodes([]int{http.StatusTeapot})}, method: http.MethodGet, status: http.StatusTeapot, expected: 3},
+		{name: "custom codes exclude defaults", opts: []ClientOption{fastRetries, WithRetryableStatusCodes([]int{http.StatusTeapot})}, method: http.MethodGet, status: http.StatusServiceUnavailable, expected: 1},
+		{name: "idempotent only post", opts: []ClientOption{fastRetries, WithRetryMax(2), WithIdempotentRetriesOnly(true)}, method: http.MethodPost, status: http.StatusServiceUnavailable, expected: 1},
+		{name: "idempotent only throttled post", opts: []ClientOption{fastRetries, WithRetryMax(2), WithIdempotentRetriesOnly(true)}, method: http.MethodPost, status: http.StatusTooManyRequests, expected: 3},
+		{name: "idempotent only put", opts: []ClientOption{fastRetries, WithRetryMax(2), WithIdempotentRetriesOnly(true)}, method: http.MethodPut, status: http.StatusServiceUnavailable, expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := newHTTPClient(configure(tt.opts...))
+			request, _ := http.NewRequest(tt.method, server.URL, nil)
+			if response, err := client.Do(request); err == nil {
+				_ = response.Body.Close()
+			}
+			if got := attempts.Load(); got != tt.expected {
+				t.Errorf("expected %d attempts, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRetryWaitBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		waitMin, waitMax                 time.Duration
+		expectedWaitMin, expectedWaitMax time.Duration
+	}{
+		{expectedWaitMin: time.Second, expectedWaitMax: 30 * time.Second},
+		{waitMin: 45 * time.Second, expectedWaitMin: 45 * time.Second, expectedWaitMax: 45 * time.Second},
+		{waitMax: 500 * time.Millisecond, expectedWaitMin: 500 * time.Millisecond, expectedWaitMax: 500 * time.Millisecond},
+		{waitMin: 2 * time.Second, waitMax: time.Minute, expectedWaitMin: 2 * time.Second, expectedWaitMax: time.Minute},
+	}
+	for _, tt := range tests {
+		waitMin, waitMax := retryWaitBounds(tt.waitMin, tt.waitMax)
+		if waitMin != tt.expectedWaitMin || waitMax != tt.expectedWaitMax {
+			t.Errorf("expected the bounds %s and %s of %s and %s, got %s and %s", tt.expectedWaitMin, tt.expectedWaitMax, tt.waitMin, tt.waitMax, waitMin, waitMax)
+		}
+	}
+}
+
+func TestNewHTTPClient_RetriesHonourContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newHTTPClient(configure(WithRetryMax(100), WithRetryWait(time.Second, time.Second)))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	start := time.Now()
+	_, err := client.Do(request)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to be exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the retries to stop at the deadline, took %s", elapsed)
+	}
+}
@@ -22,7 +22,7 @@ const (
 type RuleInterface interface {
 	Base
 	CreateRule(ctx context.Context, rule Rule) (Rule, error)
-	GetRuleByID(ctx context.Context, ruleID int) (Rule, int, error)
+	GetRuleByID(ctx context.Context, ruleID int) (Rule, error)
 	UpdateRule(ctx context.Context, rule Rule) (Rule, error)
 	DeleteRule(ctx context.Context, ruleID int) error
 	GetRuleGroup(ctx context.Context, ruleName string, ruleType string) ([]Rule, error)
@@ -55,10 +55,10 @@ func (c *Client) CreateRule(ctx context.Context, rule Rule) (createdRule Rule, e
 	return Unmarshal[Rule](response.Body)
 }
 
-func (c *Client) GetRuleByID(ctx context.Context, ruleID int) (rule Rule, statusCode int, err error) {
+func (c *Client) GetRuleByID(ctx context.Context, ruleID int) (rule Rule, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getRuleByIDURL(ruleID), nil)
 	if err != nil {
-		return Rule{}, 0, err
+		return Rule{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -67,11 +67,11 @@ func (c *Client) GetRuleByID(ctx context.Context, ruleID int) (rule Rule, status
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return Rule{}, response.StatusCode, c.ErrorFromResponse(response)
+		return Rule{}, c.ErrorFromResponse(response)
 	}
 
 	rule, err = Unmarshal[Rule](response.Body)
-	return rule, 0, err
+	return rule, err
 }
 
 func (c *Client) UpdateRule(ctx context.Context, rule Rule) (updatedRule Rule, err error) {
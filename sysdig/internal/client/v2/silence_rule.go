@@ -2,7 +2,6 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
@@ -12,8 +11,6 @@ const (
 	silenceRulePath  = "%s/api/v1/silencingRules/%d"
 )
 
-var ErrSilenceRuleNotFound = errors.New("silence rule not found")
-
 type SilenceRuleInterface interface {
 	Base
 	GetSilenceRule(ctx context.Context, id int) (SilenceRule, error)
@@ -33,9 +30,6 @@ func (c *Client) GetSilenceRule(ctx context.Context, id int) (rule SilenceRule,
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return SilenceRule{}, ErrSilenceRuleNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return SilenceRule{}, c.ErrorFromResponse(response)
 	}
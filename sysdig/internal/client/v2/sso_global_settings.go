@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrSSOGlobalSettingsNotFound = errors.New("SSO global settings not found")
-
 const (
 	ssoGlobalSettingsPath = "%s/platform/v1/global-sso-settings/%s"
 )
@@ -30,9 +27,6 @@ func (c *Client) GetSSOGlobalSettings(ctx context.Context, product string) (resu
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrSSOGlobalSettingsNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrSSOGroupMappingNotFound = errors.New("SSO group mapping not found")
-
 const (
 	createSSOGroupMappingPath = "%s/platform/v1/group-mappings"
 	getSSOGroupMappingPath    = "%s/platform/v1/group-mappings/%d"
@@ -58,9 +55,6 @@ func (c *Client) GetSSOGroupMapping(ctx context.Context, id int) (result *SSOGro
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrSSOGroupMappingNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrSSOGroupMappingSettingsNotFound = errors.New("SSO group mapping settings not found")
-
 const (
 	ssoGroupMappingSettingsPath = "%s/platform/v1/group-mappings-settings"
 )
@@ -30,9 +27,6 @@ func (c *Client) GetSSOGroupMappingSettings(ctx context.Context) (result *SSOGro
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrSSOGroupMappingSettingsNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
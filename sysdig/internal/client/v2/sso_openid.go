@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrSSOOpenIDNotFound = errors.New("SSO OpenID configuration not found")
-
 const (
 	createSSOOpenIDPath = "%s/platform/v1/sso-settings/"
 	getSSOOpenIDPath    = "%s/platform/v1/sso-settings/%d"
@@ -63,9 +60,6 @@ func (c *Client) GetSSOOpenID(ctx context.Context, isSystem bool, id int) (resul
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrSSOOpenIDNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
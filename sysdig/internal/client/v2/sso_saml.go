@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrSSOSamlNotFound = errors.New("SSO SAML configuration not found")
-
 const (
 	createSSOSamlPath = "%s/platform/v1/sso-settings/"
 	getSSOSamlPath    = "%s/platform/v1/sso-settings/%d"
@@ -63,9 +60,6 @@ func (c *Client) GetSSOSaml(ctx context.Context, isSystem bool, id int) (result
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrSSOSamlNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
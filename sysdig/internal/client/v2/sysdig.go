@@ -1,7 +1,9 @@
 package v2
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,6 +13,7 @@ import (
 type SysdigRequest struct {
 	config     *config
 	httpClient *http.Client
+	tokens     tokenSource
 
 	teamIDLock *sync.Mutex
 	teamID     *int
@@ -19,6 +22,10 @@ type SysdigRequest struct {
 type SysdigCommon interface {
 	Common
 
+	// APIToken returns the token the requests are authenticated with, from the configured
+	// static token, token file, token command or client credentials.
+	APIToken(ctx context.Context) (string, error)
+
 	CustomRoleInterface
 	CustomRolePermissionInterface
 	BuiltinRoleInterface
@@ -59,18 +66,57 @@ type SysdigSecure interface {
 	VulnerabilityRuleBundleClient
 }
 
+// Request sends a request authenticated with the token of the token source. When the API
+// rejects a cached token before its expiration, the token is renewed and the request sent again.
 func (sr *SysdigRequest) Request(ctx context.Context, method string, url string, payload io.Reader) (*http.Response, error) {
-	r, err := http.NewRequest(method, url, payload)
+	var body []byte
+	if payload != nil {
+		var err error
+		if body, err = io.ReadAll(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	token, response, err := sr.request(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
 
+	if tokens, ok := sr.tokens.(renewableTokenSource); ok && response.StatusCode == http.StatusUnauthorized {
+		_ = response.Body.Close()
+		tokens.invalidate(token)
+		_, response, err = sr.request(ctx, method, url, body)
+	}
+	return response, err
+}
+
+// request sends a request with the current token, a nil body being no payload.
+func (sr *SysdigRequest) request(ctx context.Context, method string, url string, body []byte) (string, *http.Response, error) {
+	var payload io.Reader
+	if body != nil {
+		payload = bytes.NewReader(body)
+	}
+	r, err := http.NewRequest(method, url, payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, ok := teamOverrideFromContext(ctx); ok {
+		return "", nil, ErrTeamOverrideUnsupported
+	}
+
+	token, err := sr.tokens.Token(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
 	r = r.WithContext(ctx)
-	r.Header.Set(AuthorizationHeader, fmt.Sprintf("Bearer %s", sr.config.token))
+	r.Header.Set(AuthorizationHeader, fmt.Sprintf("Bearer %s", token))
 	r.Header.Set(ContentTypeHeader, ContentTypeJSON)
 	r.Header.Set(SysdigProviderHeader, SysdigProviderHeaderValue)
 
-	return request(sr.httpClient, sr.config, r)
+	response, err := request(sr.httpClient, sr.config, r)
+	return token, response, err
 }
 
 func NewSysdigMonitor(opts ...ClientOption) SysdigMonitor {
@@ -83,17 +129,31 @@ func NewSysdigSecure(opts ...ClientOption) SysdigSecure {
 
 func newSysdigClient(opts ...ClientOption) *Client {
 	cfg := configure(opts...)
+	httpClient := newHTTPClient(cfg)
 	return &Client{
 		config: cfg,
 		requester: &SysdigRequest{
 			teamIDLock: &sync.Mutex{},
 			config:     cfg,
-			httpClient: newHTTPClient(cfg),
+			httpClient: httpClient,
+			tokens:     newTokenSource(cfg, httpClient),
 		},
 	}
 }
 
+func (c *Client) APIToken(ctx context.Context) (string, error) {
+	sr, ok := c.requester.(*SysdigRequest)
+	if !ok {
+		return "", errors.New("the client is not authenticated with a Sysdig API token")
+	}
+	return sr.tokens.Token(ctx)
+}
+
 func (sr *SysdigRequest) CurrentTeamID(ctx context.Context) (int, error) {
+	if _, ok := teamOverrideFromContext(ctx); ok {
+		return -1, ErrTeamOverrideUnsupported
+	}
+
 	sr.teamIDLock.Lock()
 	defer sr.teamIDLock.Unlock()
 
@@ -101,8 +161,13 @@ func (sr *SysdigRequest) CurrentTeamID(ctx context.Context) (int, error) {
 		return *sr.teamID, nil
 	}
 
+	token, err := sr.tokens.Token(ctx)
+	if err != nil {
+		return -1, err
+	}
+
 	user, err := getMe(ctx, sr.config, sr.httpClient, map[string]string{
-		AuthorizationHeader: fmt.Sprintf("Bearer %s", sr.config.token),
+		AuthorizationHeader: fmt.Sprintf("Bearer %s", token),
 	})
 	if err != nil {
 		return -1, err
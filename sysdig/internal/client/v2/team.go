@@ -0,0 +1,112 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+type teamOverrideKey struct{}
+
+// ErrTeamOverrideUnsupported is returned by the clients which can't issue their requests in
+// another team than the one they authenticate with. Only the IBM clients select the team of
+// each request, the Sysdig API tokens are bound to the team they were issued for.
+var ErrTeamOverrideUnsupported = errors.New("the team of the requests can only be overridden with IBM Cloud Monitoring, the requests to Sysdig are issued in the team of the API token")
+
+// TeamOverride selects the team in which the requests of a context are issued, instead of
+// the team the client authenticates with. The ID takes precedence over the name. It is only
+// supported by the IBM clients.
+type TeamOverride struct {
+	ID   int
+	Name string
+}
+
+// WithTeamOverride returns a context whose requests are issued in the given team.
+// An empty override leaves the context untouched.
+func WithTeamOverride(ctx context.Context, team TeamOverride) context.Context {
+	if team.ID == 0 && team.Name == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, teamOverrideKey{}, team)
+}
+
+func teamOverrideFromContext(ctx context.Context) (TeamOverride, bool) {
+	team, ok := ctx.Value(teamOverrideKey{}).(TeamOverride)
+	return team, ok
+}
+
+// teamNameCache keeps the IDs of the teams resolved by name, as a name lookup would
+// otherwise be issued before every request of a team scoped resource.
+type teamNameCache struct {
+	lock sync.Mutex
+	ids  map[string]int
+}
+
+// overrideTeamID returns the ID of the team set on the context with WithTeamOverride, if any.
+func (c *teamNameCache) overrideTeamID(ctx context.Context, lookup func(ctx context.Context, name string) (int, error)) (teamID int, ok bool, err error) {
+	team, ok := teamOverrideFromContext(ctx)
+	if !ok {
+		return -1, false, nil
+	}
+	if team.ID != 0 {
+		return team.ID, true, nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if teamID, ok := c.ids[team.Name]; ok {
+		return teamID, true, nil
+	}
+
+	teamID, err = lookup(ctx, team.Name)
+	if err != nil {
+		return -1, false, fmt.Errorf("unable to resolve team %q: %w", team.Name, err)
+	}
+
+	if c.ids == nil {
+		c.ids = map[string]int{}
+	}
+	c.ids[team.Name] = teamID
+	return teamID, true, nil
+}
+
+func getTeamIDByName(ctx context.Context, cfg *config, httpClient *http.Client, name string, headers map[string]string) (teamID int, err error) {
+	r, err := http.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s%s%s", cfg.url, getTeamByNamePath, url.PathEscape(name)),
+		nil,
+	)
+	if err != nil {
+		return -1, err
+	}
+
+	r = r.WithContext(ctx)
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+
+	response, err := request(httpClient, cfg, r)
+	if err != nil {
+		return -1, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return -1, errorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[teamWrapper](response.Body)
+	if err != nil {
+		return -1, err
+	}
+
+	return wrapper.Team.ID, nil
+}
@@ -2,13 +2,10 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 )
 
-var ErrTeamServiceAccountNotFound = errors.New("team service account not found")
-
 const (
 	serviceAccountsPath      = "%s/api/serviceaccounts/team"
 	serviceAccountPath       = "%s/api/serviceaccounts/team/%d"
@@ -34,9 +31,6 @@ func (c *Client) GetTeamServiceAccountByID(ctx context.Context, id int) (team *T
 		}
 	}()
 
-	if response.StatusCode == http.StatusNotFound {
-		return nil, ErrTeamServiceAccountNotFound
-	}
 	if response.StatusCode != http.StatusOK {
 		return nil, c.ErrorFromResponse(response)
 	}
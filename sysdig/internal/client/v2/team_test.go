@@ -0,0 +1,99 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIBMRequest_TeamOverride(t *testing.T) {
+	t.Parallel()
+
+	var lookups atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == ibmIAMPath:
+			_, _ = fmt.Fprintf(w, `{"access_token":"token","expiration":%d}`, time.Now().Add(time.Hour).Unix())
+		case r.URL.Path == GetMePath:
+			_, _ = fmt.Fprint(w, `{"user":{"currentTeam":1}}`)
+		case strings.HasPrefix(r.URL.Path, getTeamByNamePath):
+			lookups.Add(1)
+			if r.URL.Path != getTeamByNamePath+"my team" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"team":{"id":42}}`)
+		default:
+			_, _ = fmt.Fprint(w, r.Header.Get(sysdigTeamIDHeader))
+		}
+	}))
+	defer server.Close()
+
+	client := newIBMClient(WithURL(server.URL), WithIBMIamURL(server.URL), WithIBMInstanceID("instance"), WithIBMAPIKey("key"))
+	requestTeam := func(ctx context.Context) string {
+		t.Helper()
+		response, err := client.requester.Request(ctx, http.MethodGet, server.URL+"/api/alerts", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatalf("failed to read response body, %v", err)
+		}
+		return string(body)
+	}
+
+	if got := requestTeam(context.Background()); got != "1" {
+		t.Errorf("expected the current team without override, got %s", got)
+	}
+	if got := requestTeam(WithTeamOverride(context.Background(), TeamOverride{ID: 7, Name: "ignored"})); got != "7" {
+		t.Errorf("expected team 7, got %s", got)
+	}
+
+	byName := WithTeamOverride(context.Background(), TeamOverride{Name: "my team"})
+	for range 2 {
+		if got := requestTeam(byName); got != "42" {
+			t.Errorf("expected team 42, got %s", got)
+		}
+	}
+	if got := lookups.Load(); got != 1 {
+		t.Errorf("expected the team name to be resolved once, got %d lookups", got)
+	}
+
+	_, err := client.requester.Request(WithTeamOverride(context.Background(), TeamOverride{Name: "unknown"}), http.MethodGet, server.URL+"/api/alerts", nil)
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error for an unknown team, got %v", err)
+	}
+}
+
+func TestSysdigRequest_TeamOverrideUnsupported(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	ctx := WithTeamOverride(context.Background(), TeamOverride{ID: 7})
+	if _, err := client.requester.Request(ctx, http.MethodGet, server.URL+"/api/alerts", nil); !errors.Is(err, ErrTeamOverrideUnsupported) {
+		t.Errorf("expected the override to be rejected, got %v", err)
+	}
+	if _, err := client.CurrentTeamID(ctx); !errors.Is(err, ErrTeamOverrideUnsupported) {
+		t.Errorf("expected the override to be rejected, got %v", err)
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("expected no request to be sent, got %d", got)
+	}
+}
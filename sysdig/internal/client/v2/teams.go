@@ -15,7 +15,7 @@ const (
 type TeamInterface interface {
 	Base
 	GetUserIDByEmail(ctx context.Context, userRoles []UserRoles) ([]UserRoles, error)
-	GetTeamByID(ctx context.Context, id int) (t Team, statusCode int, err error)
+	GetTeamByID(ctx context.Context, id int) (t Team, err error)
 	CreateTeam(ctx context.Context, tRequest Team) (t Team, err error)
 	UpdateTeam(ctx context.Context, tRequest Team) (t Team, err error)
 	DeleteTeam(ctx context.Context, id int) error
@@ -66,10 +66,10 @@ func (c *Client) GetUserIDByEmail(ctx context.Context, userRoles []UserRoles) (m
 	return modifiedUserRoles, nil
 }
 
-func (c *Client) GetTeamByID(ctx context.Context, id int) (team Team, statusCode int, err error) {
+func (c *Client) GetTeamByID(ctx context.Context, id int) (team Team, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getTeamURL(id), nil)
 	if err != nil {
-		return Team{}, 0, err
+		return Team{}, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -78,15 +78,15 @@ func (c *Client) GetTeamByID(ctx context.Context, id int) (team Team, statusCode
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return Team{}, response.StatusCode, c.ErrorFromResponse(response)
+		return Team{}, c.ErrorFromResponse(response)
 	}
 
 	wrapper, err := Unmarshal[teamWrapper](response.Body)
 	if err != nil {
-		return Team{}, response.StatusCode, c.ErrorFromResponse(response)
+		return Team{}, c.ErrorFromResponse(response)
 	}
 
-	return wrapper.Team, response.StatusCode, err
+	return wrapper.Team, err
 }
 
 func (c *Client) CreateTeam(ctx context.Context, team Team) (createdTeam Team, err error) {
@@ -0,0 +1,234 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	oauthGrantTypeFormValue         = "grant_type"
+	oauthClientIDFormValue          = "client_id"
+	oauthClientSecretFormValue      = "client_secret"
+	oauthScopeFormValue             = "scope"
+	oauthClientCredentialsGrantType = "client_credentials"
+	oauthContentType                = "application/" + ContentTypeFormURLEncoded
+
+	// tokenExpiryMargin renews the tokens a bit before they expire, so that
+	// a request is never sent with a token about to expire in flight.
+	tokenExpiryMargin = 30 * time.Second
+	// defaultTokenTTL is how long a token is reused when its expiration is unknown.
+	defaultTokenTTL = 5 * time.Minute
+)
+
+var errMissingAccessToken = errors.New("the token response does not contain an access token")
+
+// tokenSource provides the bearer token of the requests sent to Sysdig.
+type tokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// newTokenSource picks the authentication mode of the client from its configuration:
+// client credentials exchanged against an OAuth token endpoint, a token file, a token
+// command or, by default, the static API token.
+func newTokenSource(cfg *config, httpClient *http.Client) tokenSource {
+	switch {
+	case cfg.clientID != "":
+		return &cachedTokenSource{fetch: func(ctx context.Context) (string, time.Time, error) {
+			return exchangeClientCredentials(ctx, cfg, httpClient)
+		}}
+	case cfg.tokenFile != "":
+		return &fileTokenSource{path: cfg.tokenFile}
+	case cfg.tokenCommand != "":
+		return &cachedTokenSource{fetch: func(ctx context.Context) (string, time.Time, error) {
+			return runTokenCommand(ctx, cfg.tokenCommand)
+		}}
+	default:
+		return staticTokenSource(cfg.token)
+	}
+}
+
+// renewableTokenSource is a tokenSource whose token can be dropped when the API rejects it
+// before it expires, for instance when it is revoked, so that a new one is fetched.
+type renewableTokenSource interface {
+	tokenSource
+	invalidate(token string)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// cachedTokenSource reuses the last token fetched until it is about to expire.
+type cachedTokenSource struct {
+	fetch func(ctx context.Context) (token string, expiration time.Time, err error)
+
+	lock       sync.Mutex
+	token      string
+	expiration time.Time
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenExpiryMargin).Before(s.expiration) {
+		return s.token, nil
+	}
+
+	token, expiration, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token
+	s.expiration = expiration
+	return s.token, nil
+}
+
+// invalidate drops the cached token if it is still the given one, the token being possibly
+// renewed meanwhile by a concurrent request.
+func (s *cachedTokenSource) invalidate(token string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+// fileTokenSource reads the token from a file, reloading it whenever the file changes
+// so that tokens rotated by an external agent are picked up.
+type fileTokenSource struct {
+	path string
+
+	lock    sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+func (s *fileTokenSource) Token(_ context.Context) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the token file: %w", err)
+	}
+	if s.token != "" && info.ModTime().Equal(s.modTime) {
+		return s.token, nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("the token file %s is empty", s.path)
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	return s.token, nil
+}
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (r oauthTokenResponse) expiration() time.Time {
+	if r.ExpiresIn <= 0 {
+		return time.Now().Add(defaultTokenTTL)
+	}
+	return time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+}
+
+func exchangeClientCredentials(ctx context.Context, cfg *config, httpClient *http.Client) (token string, expiration time.Time, err error) {
+	data := url.Values{}
+	data.Set(oauthGrantTypeFormValue, oauthClientCredentialsGrantType)
+	data.Set(oauthClientIDFormValue, cfg.clientID)
+	data.Set(oauthClientSecretFormValue, cfg.clientSecret)
+	if cfg.tokenScope != "" {
+		data.Set(oauthScopeFormValue, cfg.tokenScope)
+	}
+
+	r, err := http.NewRequest(http.MethodPost, cfg.tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	r = r.WithContext(ctx)
+	r.Header.Set(ContentTypeHeader, oauthContentType)
+
+	response, err := send(httpClient, cfg, r)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer func() {
+		if dErr := response.Body.Close(); dErr != nil {
+			err = fmt.Errorf("unable to close response body: %w", dErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return "", time.Time{}, errorFromResponse(response)
+	}
+
+	tokenResponse, err := Unmarshal[oauthTokenResponse](response.Body)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", time.Time{}, errMissingAccessToken
+	}
+
+	return tokenResponse.AccessToken, tokenResponse.expiration(), nil
+}
+
+// runTokenCommand runs the configured command through the shell. Its output is either
+// the raw token or an OAuth token response with the access_token and expires_in fields.
+func runTokenCommand(ctx context.Context, command string) (string, time.Time, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to run the token command: %w", err)
+	}
+
+	output := strings.TrimSpace(string(out))
+	if strings.HasPrefix(output, "{") {
+		var tokenResponse oauthTokenResponse
+		if err := json.Unmarshal([]byte(output), &tokenResponse); err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to parse the token command output: %w", err)
+		}
+		if tokenResponse.AccessToken == "" {
+			return "", time.Time{}, errMissingAccessToken
+		}
+		return tokenResponse.AccessToken, tokenResponse.expiration(), nil
+	}
+
+	if output == "" {
+		return "", time.Time{}, errors.New("the token command did not output a token")
+	}
+	return output, time.Now().Add(defaultTokenTTL), nil
+}
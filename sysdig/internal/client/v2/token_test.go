@@ -0,0 +1,181 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form, %v", err)
+		}
+		if r.Form.Get(oauthGrantTypeFormValue) != oauthClientCredentialsGrantType ||
+			r.Form.Get(oauthClientIDFormValue) != "id" ||
+			r.Form.Get(oauthClientSecretFormValue) != "secret" ||
+			r.Form.Get(oauthScopeFormValue) != "sysdig" ||
+			r.Header.Get("X-Sysdig-Tenant") != "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		call := calls.Add(1)
+		w.Header().Set(ContentTypeHeader, ContentTypeJSON)
+		// the first token expires within the renewal margin, the second one is reused
+		expiresIn := 3600
+		if call == 1 {
+			expiresIn = 10
+		}
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":%d}`, call, expiresIn)
+	}))
+	defer server.Close()
+
+	// the extra headers are sent to the Sysdig API only
+	cfg := configure(WithClientCredentials(server.URL, "id", "secret", "sysdig"), WithExtraHeaders(map[string]string{"X-Sysdig-Tenant": "tenant"}))
+	tokens := newTokenSource(cfg, server.Client())
+
+	for _, want := range []string{"token-1", "token-2", "token-2"} {
+		got, err := tokens.Token(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 token requests, got %d", got)
+	}
+}
+
+func TestClientCredentialsTokenSource_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	cfg := configure(WithClientCredentials(server.URL, "id", "wrong", ""))
+	_, err := newTokenSource(cfg, server.Client()).Token(context.Background())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestSysdigRequest_RenewsRejectedToken(t *testing.T) {
+	t.Parallel()
+
+	var tokens atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			w.Header().Set(ContentTypeHeader, ContentTypeJSON)
+			_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":3600}`, tokens.Add(1))
+			return
+		}
+		// the first token is revoked before it expires
+		if r.Header.Get(AuthorizationHeader) != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithClientCredentials(server.URL+"/token", "id", "secret", ""))
+	response, err := client.requester.Request(context.Background(), http.MethodPut, server.URL+"/api/object", strings.NewReader(`{"name":"object"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK || string(body) != `{"name":"object"}` {
+		t.Errorf("expected the request to be sent again with a new token, got %d %s", response.StatusCode, body)
+	}
+	if got := tokens.Load(); got != 2 {
+		t.Errorf("expected 2 token requests, got %d", got)
+	}
+}
+
+func TestFileTokenSource(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatalf("failed to write token file, %v", err)
+	}
+
+	tokens := newTokenSource(configure(WithTokenFile(path)), http.DefaultClient)
+	got, err := tokens.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "first" {
+		t.Errorf("expected first, got %s", got)
+	}
+
+	if err := os.WriteFile(path, []byte("second"), 0o600); err != nil {
+		t.Fatalf("failed to write token file, %v", err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("failed to touch token file, %v", err)
+	}
+
+	got, err = tokens.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "second" {
+		t.Errorf("expected the rotated token, got %s", got)
+	}
+}
+
+func TestRunTokenCommand(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands are written for sh")
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		want    string
+		wantErr bool
+	}{
+		{name: "raw token", command: "echo raw-token", want: "raw-token"},
+		{name: "token response", command: `echo '{"access_token":"json-token","expires_in":60}'`, want: "json-token"},
+		{name: "missing access token", command: `echo '{"expires_in":60}'`, wantErr: true},
+		{name: "empty output", command: "true", wantErr: true},
+		{name: "failure", command: "exit 1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, _, err := runTokenCommand(context.Background(), tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
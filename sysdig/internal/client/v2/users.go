@@ -17,19 +17,19 @@ const (
 
 type UserInterface interface {
 	Base
-	GetUserByID(ctx context.Context, id int) (*User, int, error)
-	GetUserByUsername(ctx context.Context, username string) (*User, int, error)
-	GetUserByEmail(ctx context.Context, email string) (*User, int, error)
+	GetUserByID(ctx context.Context, id int) (*User, error)
+	GetUserByUsername(ctx context.Context, username string) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	CreateUser(ctx context.Context, user *User) (*User, error)
 	UpdateUser(ctx context.Context, user *User) (*User, error)
 	DeleteUser(ctx context.Context, id int) error
 	GetCurrentUser(ctx context.Context) (u *User, err error)
 }
 
-func (c *Client) GetUserByID(ctx context.Context, id int) (user *User, statusCode int, error error) {
+func (c *Client) GetUserByID(ctx context.Context, id int) (user *User, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getUserURL(id), nil)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -38,21 +38,21 @@ func (c *Client) GetUserByID(ctx context.Context, id int) (user *User, statusCod
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, response.StatusCode, c.ErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	wrapper, err := Unmarshal[userWrapper](response.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return &wrapper.User, response.StatusCode, nil
+	return &wrapper.User, nil
 }
 
-func (c *Client) GetUserByUsername(ctx context.Context, username string) (user *User, statusCode int, err error) {
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (user *User, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, c.getUserByUsernameURL(username), nil)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -61,18 +61,18 @@ func (c *Client) GetUserByUsername(ctx context.Context, username string) (user *
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, response.StatusCode, c.ErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	wrapper, err := Unmarshal[userWrapper](response.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return &wrapper.User, response.StatusCode, nil
+	return &wrapper.User, nil
 }
 
-func (c *Client) GetUserByEmail(ctx context.Context, email string) (*User, int, error) {
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	return c.GetUserByUsername(ctx, email)
 }
 
@@ -16,21 +16,21 @@ const (
 type PostureVulnerabilityAcceptRiskInterface interface {
 	Base
 
-	SaveAcceptVulnerabilityRisk(ctx context.Context, p *AcceptVulnerabilityRiskRequest) (*AcceptVulnerabilityRisk, int, error)
-	GetAcceptanceVulnerabilityRiskByID(ctx context.Context, id string) (*AcceptVulnerabilityRisk, int, error)
+	SaveAcceptVulnerabilityRisk(ctx context.Context, p *AcceptVulnerabilityRiskRequest) (*AcceptVulnerabilityRisk, error)
+	GetAcceptanceVulnerabilityRiskByID(ctx context.Context, id string) (*AcceptVulnerabilityRisk, error)
 	DeleteAcceptanceVulnerabilityRisk(ctx context.Context, id string) error
-	UpdateAcceptanceVulnerabilityRisk(ctx context.Context, p *UpdateAcceptVulnerabilityRiskRequest) (*AcceptVulnerabilityRisk, int, error)
+	UpdateAcceptanceVulnerabilityRisk(ctx context.Context, p *UpdateAcceptVulnerabilityRiskRequest) (*AcceptVulnerabilityRisk, error)
 }
 
-func (c *Client) SaveAcceptVulnerabilityRisk(ctx context.Context, p *AcceptVulnerabilityRiskRequest) (risk *AcceptVulnerabilityRisk, statusCode int, err error) {
+func (c *Client) SaveAcceptVulnerabilityRisk(ctx context.Context, p *AcceptVulnerabilityRiskRequest) (risk *AcceptVulnerabilityRisk, err error) {
 	payload, err := Marshal(p)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPost, fmt.Sprintf(acceptVulnerabilityRiskCreatePath, c.config.url), payload)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -39,21 +39,21 @@ func (c *Client) SaveAcceptVulnerabilityRisk(ctx context.Context, p *AcceptVulne
 	}()
 
 	if response.StatusCode != http.StatusCreated {
-		return nil, response.StatusCode, c.ErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	resp, err := Unmarshal[AcceptVulnerabilityRisk](response.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return &resp, 0, nil
+	return &resp, nil
 }
 
-func (c *Client) GetAcceptanceVulnerabilityRiskByID(ctx context.Context, id string) (risk *AcceptVulnerabilityRisk, statusCode int, err error) {
+func (c *Client) GetAcceptanceVulnerabilityRiskByID(ctx context.Context, id string) (risk *AcceptVulnerabilityRisk, err error) {
 	response, err := c.requester.Request(ctx, http.MethodGet, fmt.Sprintf(acceptVulnerabilityRiskGetPath, c.config.url, id), nil)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -62,15 +62,15 @@ func (c *Client) GetAcceptanceVulnerabilityRiskByID(ctx context.Context, id stri
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, response.StatusCode, c.ErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	resp, err := Unmarshal[AcceptVulnerabilityRisk](response.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return &resp, 0, nil
+	return &resp, nil
 }
 
 func (c *Client) DeleteAcceptanceVulnerabilityRisk(ctx context.Context, id string) (err error) {
@@ -91,15 +91,15 @@ func (c *Client) DeleteAcceptanceVulnerabilityRisk(ctx context.Context, id strin
 	return nil
 }
 
-func (c *Client) UpdateAcceptanceVulnerabilityRisk(ctx context.Context, p *UpdateAcceptVulnerabilityRiskRequest) (risk *AcceptVulnerabilityRisk, statusCode int, err error) {
+func (c *Client) UpdateAcceptanceVulnerabilityRisk(ctx context.Context, p *UpdateAcceptVulnerabilityRiskRequest) (risk *AcceptVulnerabilityRisk, err error) {
 	payload, err := Marshal(p)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	response, err := c.requester.Request(ctx, http.MethodPut, fmt.Sprintf(acceptVulnerabilityRiskUpdatePath, c.config.url, p.ID), payload)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() {
 		if dErr := response.Body.Close(); dErr != nil {
@@ -108,13 +108,13 @@ func (c *Client) UpdateAcceptanceVulnerabilityRisk(ctx context.Context, p *Updat
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, response.StatusCode, c.ErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	resp, err := Unmarshal[AcceptVulnerabilityRisk](response.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return &resp, 0, nil
+	return &resp, nil
 }
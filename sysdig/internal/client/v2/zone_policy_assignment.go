@@ -28,7 +28,7 @@ func (c *Client) GetZonePolicyAssignment(ctx context.Context, zoneID int) (resul
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*ZonePolicyAssignment](response.Body)
@@ -51,7 +51,7 @@ func (c *Client) CreateZonePolicyAssignment(ctx context.Context, zoneID int, req
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*ZonePolicyAssignment](response.Body)
@@ -74,7 +74,7 @@ func (c *Client) UpdateZonePolicyAssignment(ctx context.Context, zoneID int, req
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*ZonePolicyAssignment](response.Body)
@@ -92,7 +92,7 @@ func (c *Client) DeleteZonePolicyAssignment(ctx context.Context, zoneID int) (er
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
-		return c.APIErrorFromResponse(response)
+		return c.ErrorFromResponse(response)
 	}
 
 	return nil
@@ -36,7 +36,7 @@ func (c *Client) GetZones(ctx context.Context, name string) (zones []Zone, err e
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 	wrapper, err := Unmarshal[ZonesWrapper](response.Body)
 	if err != nil {
@@ -58,7 +58,7 @@ func (c *Client) GetZoneByID(ctx context.Context, id int) (zone *Zone, err error
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*Zone](response.Body)
@@ -81,7 +81,7 @@ func (c *Client) CreateZone(ctx context.Context, zone *ZoneRequest) (createdZone
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*Zone](response.Body)
@@ -104,7 +104,7 @@ func (c *Client) UpdateZone(ctx context.Context, zone *ZoneRequest) (updatedZone
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*Zone](response.Body)
@@ -122,7 +122,7 @@ func (c *Client) DeleteZone(ctx context.Context, id int) (err error) {
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
-		return c.APIErrorFromResponse(response)
+		return c.ErrorFromResponse(response)
 	}
 
 	return nil
@@ -36,7 +36,7 @@ func (c *Client) GetZonesV2(ctx context.Context, name string) (zones []ZoneV2, e
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 	wrapper, err := Unmarshal[ZonesV2Wrapper](response.Body)
 	if err != nil {
@@ -58,7 +58,7 @@ func (c *Client) GetZoneV2(ctx context.Context, id int) (zone *ZoneV2, err error
 	}()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*ZoneV2](response.Body)
@@ -81,7 +81,7 @@ func (c *Client) CreateZoneV2(ctx context.Context, zone *ZoneV2) (createdZone *Z
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*ZoneV2](response.Body)
@@ -104,7 +104,7 @@ func (c *Client) UpdateZoneV2(ctx context.Context, zone *ZoneV2) (updatedZone *Z
 	}()
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		return nil, c.APIErrorFromResponse(response)
+		return nil, c.ErrorFromResponse(response)
 	}
 
 	return Unmarshal[*ZoneV2](response.Body)
@@ -126,7 +126,7 @@ func (c *Client) DeleteZoneV2(ctx context.Context, id int) (err error) {
 	}()
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
-		return c.APIErrorFromResponse(response)
+		return c.ErrorFromResponse(response)
 	}
 
 	return nil
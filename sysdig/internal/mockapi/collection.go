@@ -0,0 +1,169 @@
+package mockapi
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// collection is the in-memory state of one kind of object of the API. Objects are
+// stored as the JSON documents sent by the client, the server only manages their
+// "id" and "version" fields.
+type collection struct {
+	// wrapper is the key the API wraps single objects in, e.g. {"team": {...}}.
+	wrapper string
+	// listKey is the key the API wraps lists in, lists are bare arrays when empty.
+	listKey string
+	// stringIDs renders the IDs as JSON strings, as the posture endpoints do.
+	stringIDs bool
+	// versioned objects get a version bumped on every update and updates
+	// carrying a stale version are rejected with 409, like the API does.
+	versioned bool
+
+	lock    sync.Mutex
+	lastID  int
+	objects map[int]map[string]any
+}
+
+type object = map[string]any
+
+func (c *collection) create(obj object) object {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.lastID++
+	c.setID(obj, c.lastID)
+	if c.versioned {
+		obj["version"] = 1
+	}
+	if c.objects == nil {
+		c.objects = map[int]object{}
+	}
+	c.objects[c.lastID] = obj
+	return obj
+}
+
+func (c *collection) get(id int) (object, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	obj, ok := c.objects[id]
+	return obj, ok
+}
+
+func (c *collection) update(id int, obj object) (object, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	current, ok := c.objects[id]
+	if !ok {
+		return nil, http.StatusNotFound
+	}
+
+	c.setID(obj, id)
+	if c.versioned {
+		version := versionOf(current)
+		if sent, ok := obj["version"]; ok && versionOf(object{"version": sent}) != version {
+			return nil, http.StatusConflict
+		}
+		obj["version"] = version + 1
+	}
+	c.objects[id] = obj
+	return obj, http.StatusOK
+}
+
+// save creates the object, or updates it when it carries the ID of an existing one,
+// as the endpoints of the API handling both operations with a single POST do.
+func (c *collection) save(obj object) (object, int) {
+	if id, ok := c.idOf(obj); ok {
+		if _, exists := c.get(id); exists {
+			return c.update(id, obj)
+		}
+	}
+	return c.create(obj), http.StatusOK
+}
+
+func (c *collection) delete(id int) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, ok := c.objects[id]
+	delete(c.objects, id)
+	return ok
+}
+
+func (c *collection) list(filter func(object) bool) []object {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	objects := []object{}
+	for _, id := range slices.Sorted(maps.Keys(c.objects)) {
+		if filter == nil || filter(c.objects[id]) {
+			objects = append(objects, c.objects[id])
+		}
+	}
+	return objects
+}
+
+func (c *collection) setID(obj object, id int) {
+	if c.stringIDs {
+		obj["id"] = strconv.Itoa(id)
+		return
+	}
+	obj["id"] = id
+}
+
+func (c *collection) idOf(obj object) (int, bool) {
+	switch id := obj["id"].(type) {
+	case string:
+		value, err := strconv.Atoi(id)
+		return value, err == nil
+	case json.Number:
+		value, err := id.Int64()
+		return int(value), err == nil
+	case float64:
+		return int(id), true
+	case int:
+		return id, true
+	}
+	return 0, false
+}
+
+func (c *collection) wrap(obj object) any {
+	if c.wrapper == "" {
+		return obj
+	}
+	return map[string]any{c.wrapper: obj}
+}
+
+// unwrap extracts the object from a request body. Some endpoints answer with wrapped
+// objects but expect them bare in requests, so both forms are accepted.
+func (c *collection) unwrap(body map[string]any) object {
+	if obj, ok := body[c.wrapper].(map[string]any); ok && c.wrapper != "" {
+		return obj
+	}
+	return body
+}
+
+func (c *collection) wrapList(objects []object) any {
+	if c.listKey == "" {
+		return objects
+	}
+	return map[string]any{c.listKey: objects}
+}
+
+func versionOf(obj object) int {
+	switch version := obj["version"].(type) {
+	case json.Number:
+		value, _ := version.Int64()
+		return int(value)
+	case float64:
+		return int(version)
+	case int:
+		return version
+	}
+	return 0
+}
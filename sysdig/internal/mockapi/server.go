@@ -0,0 +1,354 @@
+// Package mockapi provides an in-memory fake of the Sysdig REST API, so that the
+// acceptance tests can run hermetically against it instead of a real Sysdig backend.
+//
+// Objects are stored as sent by the client and returned as is: the fake manages
+// their IDs and versions, but it does not emulate the defaults or validations of
+// the real API.
+package mockapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+)
+
+// Token is the API token accepted by the fake server.
+const Token = "00000000-0000-0000-0000-000000000000"
+
+const (
+	// DefaultTeamID is the ID of the team the user of Token is logged in.
+	DefaultTeamID = 1
+	defaultUserID = 1
+	defaultUser   = "mock@sysdig.com"
+)
+
+// Server is a fake Sysdig API listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	teams                    *collection
+	users                    *collection
+	notificationChannels     *collection
+	alertsV2                 *collection
+	dashboards               *collection
+	dashboardFolders         *collection
+	silenceRules             *collection
+	policies                 *collection
+	rules                    *collection
+	lists                    *collection
+	macros                   *collection
+	zones                    *collection
+	postureControls          *collection
+	posturePolicies          *collection
+	postureZones             *collection
+	vulnerabilityPolicies    *collection
+	vulnerabilityBundles     *collection
+	vulnerabilityAcceptRisks *collection
+}
+
+// NewServer starts a fake Sysdig API. It must be closed once the tests are done.
+func NewServer() *Server {
+	s := &Server{
+		teams:                    &collection{wrapper: "team", listKey: "teams", versioned: true},
+		users:                    &collection{wrapper: "user", listKey: "users", versioned: true},
+		notificationChannels:     &collection{wrapper: "notificationChannel", listKey: "notificationChannels", versioned: true},
+		alertsV2:                 &collection{wrapper: "alert", listKey: "alerts", versioned: true},
+		dashboards:               &collection{wrapper: "dashboard", listKey: "dashboards", versioned: true},
+		dashboardFolders:         &collection{wrapper: "folder", listKey: "folders", versioned: true},
+		silenceRules:             &collection{versioned: true},
+		policies:                 &collection{versioned: true},
+		rules:                    &collection{versioned: true},
+		lists:                    &collection{versioned: true},
+		macros:                   &collection{versioned: true},
+		zones:                    &collection{listKey: "data"},
+		postureControls:          &collection{wrapper: "data", stringIDs: true},
+		posturePolicies:          &collection{wrapper: "data", listKey: "data", stringIDs: true},
+		postureZones:             &collection{wrapper: "data", stringIDs: true},
+		vulnerabilityPolicies:    &collection{},
+		vulnerabilityBundles:     &collection{},
+		vulnerabilityAcceptRisks: &collection{stringIDs: true},
+	}
+
+	s.teams.create(object{
+		"name":        "Monitor Operations",
+		"description": "Default team",
+		"theme":       "#7BB0B2",
+		"products":    []any{"SDC", "SDS"},
+		"default":     true,
+		"immutable":   true,
+	})
+	s.users.create(object{
+		"username":    defaultUser,
+		"firstName":   "Mock",
+		"lastName":    "User",
+		"systemRole":  "ROLE_CUSTOMER",
+		"currentTeam": DefaultTeamID,
+	})
+
+	s.Server = httptest.NewServer(s.authenticate(s.routes()))
+	return s
+}
+
+func (s *Server) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/users/me", s.getMe)
+	mux.HandleFunc("GET /api/users/light", s.handleList(s.users, nil))
+	mux.HandleFunc("GET /api/users/{id}", s.getUser)
+	mux.HandleFunc("PUT /api/users/{id}", s.handleUpdate(s.users))
+	mux.HandleFunc("DELETE /api/users/{id}", s.handleDelete(s.users))
+	mux.HandleFunc("POST /api/user/provisioning/", s.handleCreate(s.users))
+
+	s.handleCollection(mux, "/api/teams", s.teams)
+	mux.HandleFunc("GET /api/v2/teams/light/name/{name}", s.getTeamByName)
+
+	s.handleCollection(mux, "/api/notificationChannels", s.notificationChannels)
+	s.handleCollection(mux, "/api/v2/alerts", s.alertsV2)
+	mux.HandleFunc("GET /api/v3/labels", getLabels)
+	mux.HandleFunc("GET /api/v3/labels/{$}", getLabels)
+	mux.HandleFunc("GET /api/v3/labels/descriptors/{id}", getLabelDescriptor)
+	s.handleCollection(mux, "/api/v3/dashboards", s.dashboards)
+	s.handleCollection(mux, "/api/v3/dashboards/folders", s.dashboardFolders)
+	s.handleCollection(mux, "/api/v1/silencingRules", s.silenceRules)
+
+	s.handleCollection(mux, "/api/v2/policies", s.policies)
+	mux.HandleFunc("GET /api/secure/rules/groups", s.handleList(s.rules, matchQuery("name", "name")))
+	s.handleCollection(mux, "/api/secure/rules", s.rules)
+	s.handleCollection(mux, "/api/secure/falco/lists", s.lists)
+	s.handleCollection(mux, "/api/secure/falco/macros", s.macros)
+	s.handleCollection(mux, "/platform/v1/zones", s.zones)
+
+	mux.HandleFunc("POST /api/cspm/v1/policy/controls", s.handleSave(s.postureControls))
+	mux.HandleFunc("GET /api/cspm/v1/policy/controls/view/{id}", s.handleGet(s.postureControls))
+	mux.HandleFunc("DELETE /api/cspm/v1/policy/controls/{id}", s.handleDelete(s.postureControls))
+	mux.HandleFunc("POST /api/cspm/v1/policy", s.handleSave(s.posturePolicies))
+	mux.HandleFunc("GET /api/cspm/v1/policy/policies/list", s.handleList(s.posturePolicies, nil))
+	mux.HandleFunc("GET /api/cspm/v1/policy/posture/policies/{id}", s.handleGet(s.posturePolicies))
+	mux.HandleFunc("DELETE /api/cspm/v1/policy/policies/{id}", s.handleDelete(s.posturePolicies))
+	mux.HandleFunc("POST /api/cspm/v1/policy/zones", s.handleSave(s.postureZones))
+	mux.HandleFunc("GET /api/cspm/v1/policy/zones/{id}", s.handleGet(s.postureZones))
+	mux.HandleFunc("DELETE /api/cspm/v1/policy/zones/{id}", s.handleDelete(s.postureZones))
+
+	s.handleCollection(mux, "/secure/vulnerability/v1/policies", s.vulnerabilityPolicies)
+	s.handleCollection(mux, "/secure/vulnerability/v1/bundles", s.vulnerabilityBundles)
+	s.handleCollection(mux, "/secure/vulnerability/v1beta1/accepted-risks", s.vulnerabilityAcceptRisks)
+
+	return mux
+}
+
+// handleCollection serves the usual CRUD endpoints of a collection under path.
+func (s *Server) handleCollection(mux *http.ServeMux, path string, c *collection) {
+	mux.HandleFunc("GET "+path, s.handleList(c, nil))
+	mux.HandleFunc("POST "+path, s.handleCreate(c))
+	mux.HandleFunc("GET "+path+"/{id}", s.handleGet(c))
+	mux.HandleFunc("PUT "+path+"/{id}", s.handleUpdate(c))
+	mux.HandleFunc("DELETE "+path+"/{id}", s.handleDelete(c))
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+Token {
+			writeError(w, http.StatusUnauthorized, "invalid API token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleList(c *collection, filter func(*http.Request) func(object) bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var match func(object) bool
+		if filter != nil {
+			match = filter(r)
+		}
+		writeJSON(w, http.StatusOK, c.wrapList(c.list(match)))
+	}
+}
+
+func (s *Server) handleCreate(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		obj, ok := readObject(w, r, c)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, c.wrap(c.create(obj)))
+	}
+}
+
+func (s *Server) handleSave(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		obj, ok := readObject(w, r, c)
+		if !ok {
+			return
+		}
+		saved, status := c.save(obj)
+		if status != http.StatusOK {
+			writeError(w, status, http.StatusText(status))
+			return
+		}
+		writeJSON(w, http.StatusOK, c.wrap(saved))
+	}
+}
+
+func (s *Server) handleGet(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		obj, ok := c.get(id)
+		if !ok {
+			writeError(w, http.StatusNotFound, "object not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, c.wrap(obj))
+	}
+}
+
+func (s *Server) handleUpdate(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		obj, ok := readObject(w, r, c)
+		if !ok {
+			return
+		}
+		updated, status := c.update(id, obj)
+		if status != http.StatusOK {
+			writeError(w, status, http.StatusText(status))
+			return
+		}
+		writeJSON(w, http.StatusOK, c.wrap(updated))
+	}
+}
+
+func (s *Server) handleDelete(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		if !c.delete(id) {
+			writeError(w, http.StatusNotFound, "object not found")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) getMe(w http.ResponseWriter, _ *http.Request) {
+	user, _ := s.users.get(defaultUserID)
+	writeJSON(w, http.StatusOK, s.users.wrap(user))
+}
+
+// labels are the label descriptors known to the fake server, in dot notation with their
+// public notation, which the alerts are translated from.
+var labels = []object{
+	{"id": "host.hostName", "publicId": "host_hostname"},
+	{"id": "container.name", "publicId": "container_name"},
+	{"id": "kubernetes.cluster.name", "publicId": "kube_cluster_name"},
+	{"id": "kubernetes.namespace.name", "publicId": "kube_namespace_name"},
+	{"id": "kubernetes.workload.name", "publicId": "kube_workload_name"},
+	{"id": "kubernetes.pod.name", "publicId": "kube_pod_name"},
+}
+
+func getLabels(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, object{"allLabels": labels})
+}
+
+// getLabelDescriptor describes a label in public notation. The labels which are not known,
+// like the custom Prometheus ones, are described with their public notation as ID.
+func getLabelDescriptor(w http.ResponseWriter, r *http.Request) {
+	descriptor := object{"id": r.PathValue("id"), "publicId": r.PathValue("id")}
+	for _, label := range labels {
+		if label["publicId"] == r.PathValue("id") {
+			descriptor = label
+		}
+	}
+	writeJSON(w, http.StatusOK, object{"labelDescriptor": descriptor})
+}
+
+// getUser looks users up either by ID or by username, as the API does.
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	if _, err := strconv.Atoi(r.PathValue("id")); err == nil {
+		s.handleGet(s.users)(w, r)
+		return
+	}
+
+	users := s.users.list(func(user object) bool { return user["username"] == r.PathValue("id") })
+	if len(users) == 0 {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.users.wrap(users[0]))
+}
+
+func (s *Server) getTeamByName(w http.ResponseWriter, r *http.Request) {
+	teams := s.teams.list(func(team object) bool { return team["name"] == r.PathValue("name") })
+	if len(teams) == 0 {
+		writeError(w, http.StatusNotFound, "team not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.teams.wrap(teams[0]))
+}
+
+// matchQuery filters the listed objects whose field equals the query parameter, if set.
+func matchQuery(param, field string) func(*http.Request) func(object) bool {
+	return func(r *http.Request) func(object) bool {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			return nil
+		}
+		return func(obj object) bool { return fmt.Sprint(obj[field]) == value }
+	}
+}
+
+func pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, "object not found")
+		return 0, false
+	}
+	return id, true
+}
+
+func readObject(w http.ResponseWriter, r *http.Request, c *collection) (object, bool) {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+
+	var body map[string]any
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return nil, false
+	}
+
+	return c.unwrap(body), true
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(value); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body.Bytes())
+}
+
+// writeError answers with the error document of the API, so that the client
+// surfaces it as an APIError.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []any{map[string]any{
+			"reason":  strings.ToLower(http.StatusText(status)),
+			"message": message,
+		}},
+	})
+}
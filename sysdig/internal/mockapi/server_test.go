@@ -0,0 +1,165 @@
+//go:build unit
+
+package mockapi_test
+
+import (
+	"context"
+	"testing"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/draios/terraform-provider-sysdig/sysdig/internal/mockapi"
+)
+
+func newClient(server *mockapi.Server, token string) v2.SysdigSecure {
+	return v2.NewSysdigSecure(v2.WithURL(server.URL), v2.WithToken(token))
+}
+
+func TestServer_NotificationChannelLifecycle(t *testing.T) {
+	t.Parallel()
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := newClient(server, mockapi.Token)
+	ctx := context.Background()
+
+	created, err := client.CreateNotificationChannel(ctx, v2.NotificationChannel{
+		Type: "EMAIL",
+		Name: "mock channel",
+		Options: v2.NotificationChannelOptions{
+			EmailRecipients: []string{"foo@example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID == 0 || created.Version != 1 {
+		t.Fatalf("expected an ID and version 1, got %d and %d", created.ID, created.Version)
+	}
+
+	created.Name = "renamed channel"
+	updated, err := client.UpdateNotificationChannel(ctx, created)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("expected version 2, got %d", updated.Version)
+	}
+
+	got, err := client.GetNotificationChannelByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "renamed channel" || len(got.Options.EmailRecipients) != 1 {
+		t.Errorf("unexpected channel: %+v", got)
+	}
+
+	if err := client.DeleteNotificationChannel(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetNotificationChannelByID(ctx, created.ID); !v2.IsNotFound(err) {
+		t.Errorf("expected a not found error after deletion, got %v", err)
+	}
+}
+
+func TestServer_AlertLabels(t *testing.T) {
+	t.Parallel()
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := v2.NewSysdigMonitor(v2.WithURL(server.URL), v2.WithToken(mockapi.Token))
+	ctx := context.Background()
+
+	alert, err := client.CreateAlertV2Metric(ctx, v2.AlertV2Metric{
+		AlertV2Common: v2.AlertV2Common{Name: "CPU", Type: "MANUAL", Severity: "high"},
+		Config: v2.AlertV2ConfigMetric{
+			ScopedSegmentedConfig: v2.ScopedSegmentedConfig{
+				Scope: &v2.AlertScopeV2{Expressions: []v2.ScopeExpressionV2{
+					{Operand: "kube_cluster_name", Operator: "equals", Value: []string{"production"}},
+				}},
+				SegmentBy: []v2.AlertLabelDescriptorV2{{ID: "kube_namespace_name"}, {ID: "team"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := alert.Config.ScopedSegmentedConfig
+	if config.Scope.Expressions[0].Operand != "kubernetes.cluster.name" ||
+		config.SegmentBy[0].ID != "kubernetes.namespace.name" ||
+		config.SegmentBy[1].ID != "team" {
+		t.Errorf("expected the labels to be translated to dot notation, got %+v", config)
+	}
+
+	publicID, err := client.GetLabelPublicID(ctx, "kubernetes.cluster.name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if publicID != "kube_cluster_name" {
+		t.Errorf("expected kube_cluster_name, got %s", publicID)
+	}
+}
+
+func TestServer_CurrentUserAndTeams(t *testing.T) {
+	t.Parallel()
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := newClient(server, mockapi.Token)
+	ctx := context.Background()
+
+	teamID, err := client.CurrentTeamID(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if teamID != mockapi.DefaultTeamID {
+		t.Errorf("expected team %d, got %d", mockapi.DefaultTeamID, teamID)
+	}
+
+	if _, err := client.CreateTeam(ctx, v2.Team{Name: "mock team", Theme: "#73A1F7"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	teams, err := client.ListTeams(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(teams) != 2 {
+		t.Errorf("expected the default and the created team, got %d teams", len(teams))
+	}
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := newClient(server, "wrong")
+
+	_, err := client.CurrentTeamID(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for an invalid token")
+	}
+}
+
+func TestServer_PostureZoneSave(t *testing.T) {
+	t.Parallel()
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := newClient(server, mockapi.Token)
+	ctx := context.Background()
+
+	zone, err := client.CreateOrUpdatePostureZone(ctx, &v2.PostureZoneRequest{Name: "mock zone"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := client.CreateOrUpdatePostureZone(ctx, &v2.PostureZoneRequest{ID: zone.ID, Name: "renamed zone"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.ID != zone.ID || updated.Name != "renamed zone" {
+		t.Errorf("expected zone %s to be renamed, got %+v", zone.ID, updated)
+	}
+}
@@ -0,0 +1,35 @@
+package sysdig_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig/internal/mockapi"
+)
+
+// SysdigMockAPIEnv runs the acceptance tests against the in-memory fake of the Sysdig API
+// instead of the backend the SYSDIG_* variables point to, so they need no credentials. Like
+// common_test.go, this file has no build constraint, so that every tf_acc_* suite honours it.
+const SysdigMockAPIEnv = "SYSDIG_MOCK_API"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(SysdigMockAPIEnv) == "" {
+		os.Exit(m.Run())
+	}
+
+	server := mockapi.NewServer()
+	for env, value := range map[string]string{
+		"SYSDIG_MONITOR_URL":      server.URL,
+		SysdigMonitorApiTokenEnv:  mockapi.Token,
+		"SYSDIG_SECURE_URL":       server.URL,
+		SysdigSecureApiTokenEnv:   mockapi.Token,
+		SysdigIBMMonitorAPIKeyEnv: "",
+		SysdigIBMSecureAPIKeyEnv:  "",
+	} {
+		_ = os.Setenv(env, value)
+	}
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
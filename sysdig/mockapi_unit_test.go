@@ -0,0 +1,47 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/draios/terraform-provider-sysdig/sysdig/internal/mockapi"
+)
+
+// newMockAPIProvider starts a mock API, closed at the end of the test, points the environment of the
+// product, monitor or secure, to it and returns it with the provider configured from that environment.
+func newMockAPIProvider(t *testing.T, product string) (*mockapi.Server, *schema.Provider) {
+	t.Helper()
+	server := mockapi.NewServer()
+	t.Cleanup(server.Close)
+	t.Setenv("SYSDIG_"+strings.ToUpper(product)+"_URL", server.URL)
+	t.Setenv("SYSDIG_"+strings.ToUpper(product)+"_API_TOKEN", mockapi.Token)
+
+	provider := Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{})); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	return server, provider
+}
+
+// newMockAPIMonitorProvider returns the provider configured against a mock Monitor API, and a client
+// of that API.
+func newMockAPIMonitorProvider(t *testing.T) (*schema.Provider, v2.SysdigMonitor) {
+	t.Helper()
+	server, provider := newMockAPIProvider(t, "monitor")
+	return provider, v2.NewSysdigMonitor(v2.WithURL(server.URL), v2.WithToken(mockapi.Token))
+}
+
+// newMockAPISecureProvider returns the provider configured against a mock Secure API, and a client
+// of that API.
+func newMockAPISecureProvider(t *testing.T) (*schema.Provider, v2.SysdigSecure) {
+	t.Helper()
+	server, provider := newMockAPIProvider(t, "secure")
+	return provider, v2.NewSysdigSecure(v2.WithURL(server.URL), v2.WithToken(mockapi.Token))
+}
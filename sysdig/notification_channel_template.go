@@ -0,0 +1,239 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationChannelTemplateAction matches the actions of the templates of the custom webhooks,
+// like {{@alert_name}} or {{ if eq @alert_severity "high" }}
+var notificationChannelTemplateAction = regexp.MustCompile(`(?s)\{\{.*?\}\}`)
+
+// notificationChannelTemplateVariable matches the variables in the actions, like @alert_name
+var notificationChannelTemplateVariable = regexp.MustCompile(`@(\w+)`)
+
+// notificationChannelTemplatePlaceholder matches the actions which are a single variable, like
+// {{@alert_name}} or {{ @alert_name }}
+var notificationChannelTemplatePlaceholder = regexp.MustCompile(`\{\{\s*@(\w+)\s*\}\}`)
+
+// goTemplateBuiltins are the functions predefined by text/template
+var goTemplateBuiltins = []string{
+	"and", "call", "html", "index", "slice", "js", "len", "not", "or", "print", "printf", "println", "urlquery",
+	"eq", "ge", "gt", "le", "lt", "ne",
+}
+
+// monitorNotificationTemplateVariables are the variables of the Monitor alert notifications known
+// to the provider, with the values of the synthetic alert used to render the examples. The list is
+// not backed by a published API contract, so the variables missing from it are only reported as
+// warnings.
+var monitorNotificationTemplateVariables = map[string]string{
+	"alert_id":          "12345",
+	"alert_name":        "High CPU usage",
+	"alert_description": "The CPU usage of the host is above 90%",
+	"alert_severity":    "high",
+	"alert_status":      "triggered",
+	"alert_type":        "PROMETHEUS",
+	"alert_url":         "https://app.sysdigcloud.com/#/alerts/12345",
+	"alert_scope":       "host_hostname = ip-10-0-0-1",
+	"alert_condition":   "avg(sysdig_host_cpu_used_percent) > 90",
+	"alert_value":       "93.5",
+	"event_id":          "67890",
+	"event_state":       "ACTIVE",
+	"event_time":        "2024-01-01T00:00:00Z",
+	"event_url":         "https://app.sysdigcloud.com/#/events/67890",
+	"runbook_url":       "https://runbooks.example.com/high-cpu",
+	"dashboard_url":     "https://app.sysdigcloud.com/#/dashboards/1",
+	"team_id":           "1",
+	"team_name":         "Monitor Operations",
+}
+
+// notificationChannelTemplateIssue is a syntax error, an unknown variable or an unknown function
+// of a template, at the line and column of the template it is found at when known.
+type notificationChannelTemplateIssue struct {
+	line, column int
+	message      string
+}
+
+func (e notificationChannelTemplateIssue) Error() string {
+	if e.line == 0 {
+		return e.message
+	}
+	return fmt.Sprintf("%d:%d: %s", e.line, e.column, e.message)
+}
+
+// parseNotificationChannelTemplate parses a template as a Go template, once its variables in Sysdig
+// notation, like @alert_name, are turned into fields like .alert_name. The variables keep their
+// length, so the positions in the parsed template are the ones in the template.
+func parseNotificationChannelTemplate(template string) (*parse.Tree, error) {
+	goTemplate := notificationChannelTemplateAction.ReplaceAllStringFunc(template, func(action string) string {
+		return notificationChannelTemplateVariable.ReplaceAllString(action, ".$1")
+	})
+
+	tree := parse.New("template")
+	// the functions of the templates of Sysdig are not known, they are checked by the walk instead
+	tree.Mode = parse.SkipFuncCheck
+	return tree.Parse(goTemplate, "", "", map[string]*parse.Tree{})
+}
+
+// validateNotificationChannelTemplate returns the syntax errors of a template, and the variables
+// and functions it uses which are not in the given and builtin ones.
+func validateNotificationChannelTemplate(template string, variables map[string]string) []notificationChannelTemplateIssue {
+	tree, err := parseNotificationChannelTemplate(template)
+	if err != nil {
+		return []notificationChannelTemplateIssue{{message: strings.TrimPrefix(err.Error(), "template: ")}}
+	}
+
+	position := func(node parse.Node) (int, int) {
+		offset := int(node.Position())
+		return strings.Count(template[:offset], "\n") + 1, offset - strings.LastIndex(template[:offset], "\n")
+	}
+	var issues []notificationChannelTemplateIssue
+	walkNotificationChannelTemplate(tree.Root, func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.FieldNode:
+			if _, ok := variables[n.Ident[0]]; !ok {
+				line, column := position(n)
+				issues = append(issues, notificationChannelTemplateIssue{line, column, fmt.Sprintf("unknown variable @%s", n.Ident[0])})
+			}
+		case *parse.IdentifierNode:
+			if !slices.Contains(goTemplateBuiltins, n.Ident) {
+				line, column := position(n)
+				issues = append(issues, notificationChannelTemplateIssue{line, column, fmt.Sprintf("unknown function %s", n.Ident)})
+			}
+		}
+	})
+	return issues
+}
+
+// walkNotificationChannelTemplate calls visit on the variables and functions of the template,
+// except for the fields inside range and with, which are not variables of the notification.
+func walkNotificationChannelTemplate(node parse.Node, visit func(parse.Node)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkNotificationChannelTemplate(child, visit)
+		}
+	case *parse.ActionNode:
+		walkNotificationChannelTemplate(n.Pipe, visit)
+	case *parse.TemplateNode:
+		walkNotificationChannelTemplate(n.Pipe, visit)
+	case *parse.IfNode:
+		walkNotificationChannelTemplate(n.Pipe, visit)
+		walkNotificationChannelTemplate(n.List, visit)
+		walkNotificationChannelTemplate(n.ElseList, visit)
+	case *parse.RangeNode:
+		walkNotificationChannelTemplate(n.Pipe, visit)
+		walkNotificationChannelTemplate(n.ElseList, visit)
+	case *parse.WithNode:
+		walkNotificationChannelTemplate(n.Pipe, visit)
+		walkNotificationChannelTemplate(n.ElseList, visit)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, command := range n.Cmds {
+			walkNotificationChannelTemplate(command, visit)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			walkNotificationChannelTemplate(arg, visit)
+		}
+	case *parse.ChainNode:
+		walkNotificationChannelTemplate(n.Node, visit)
+	case *parse.FieldNode, *parse.IdentifierNode:
+		visit(n)
+	}
+}
+
+// renderNotificationChannelTemplate executes a template with the values of the variables, the
+// unknown variables being left as they are. The templates which can't be executed, because of a
+// syntax error or an unknown function, only have their single variable actions replaced.
+func renderNotificationChannelTemplate(template string, variables map[string]string) string {
+	data := map[string]string{}
+	for _, match := range notificationChannelTemplateVariable.FindAllStringSubmatch(template, -1) {
+		data[match[1]] = "{{@" + match[1] + "}}"
+	}
+	maps.Copy(data, variables)
+
+	if tree, err := parseNotificationChannelTemplate(template); err == nil {
+		t, err := texttemplate.New("template").AddParseTree("template", tree)
+		var rendered strings.Builder
+		if err == nil && t.Execute(&rendered, data) == nil {
+			return rendered.String()
+		}
+	}
+
+	return notificationChannelTemplatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		return data[notificationChannelTemplatePlaceholder.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+type notificationChannelTemplateValidator struct {
+	product   string
+	variables map[string]string
+}
+
+func (v notificationChannelTemplateValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("the template should only use the variables of the %s notifications", v.product)
+}
+
+func (v notificationChannelTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationChannelTemplateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// the variables known to the provider may be incomplete, and the templates the API accepts
+	// were applied before they were validated, so the issues don't fail the plan
+	names := slices.Sorted(maps.Keys(v.variables))
+	for _, issue := range validateNotificationChannelTemplate(req.ConfigValue.ValueString(), v.variables) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Possibly invalid notification template",
+			fmt.Sprintf("%s. The variables of the %s notifications known to the provider are @%s.", issue, v.product, strings.Join(names, ", @")),
+		)
+	}
+}
+
+// renderedTemplatePlanModifier plans the payload of a template attribute, next to the planned
+// one, for the synthetic alert of the Monitor notifications.
+type renderedTemplatePlanModifier struct {
+	template string
+}
+
+func (m renderedTemplatePlanModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("the payload of %s for a synthetic alert", m.template)
+}
+
+func (m renderedTemplatePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m renderedTemplatePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var template types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName(m.template), &template)...)
+	switch {
+	case template.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case template.IsNull():
+		resp.PlanValue = types.StringNull()
+	default:
+		resp.PlanValue = types.StringValue(renderNotificationChannelTemplate(template.ValueString(), monitorNotificationTemplateVariables))
+	}
+}
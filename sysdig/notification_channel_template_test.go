@@ -0,0 +1,74 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateNotificationChannelTemplate(t *testing.T) {
+	for _, template := range []string{
+		`{"alert": "{{@alert_name}}", "severity": "{{ @alert_severity }}"}`,
+		`{"text": "no variables"}`,
+		"{\n  \"alert\": \"{{@alert_name}}\",\n  \"url\": \"{{@alert_url}}\"\n}",
+		`{"priority": "{{ if eq @alert_severity "high" }}P1{{ else }}P3{{ end }}", "name": {{ printf "%q" @alert_name }}}`,
+		`{"labels": [{{ range $i, $label := @alert_scope }}{{ if $i }},{{ end }}"{{ .name }}"{{ end }}]}`,
+	} {
+		if issues := validateNotificationChannelTemplate(template, monitorNotificationTemplateVariables); len(issues) > 0 {
+			t.Errorf("expected %s to be valid, got %v", template, issues)
+		}
+	}
+
+	issues := validateNotificationChannelTemplate("{\n  \"alert\": \"{{@alert_nmae}}\",\n  \"host\": \"{{ upper @alert_name }}\"\n}", monitorNotificationTemplateVariables)
+	expected := []string{
+		"2:15: unknown variable @alert_nmae",
+		"3:15: unknown function upper",
+	}
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %v", len(expected), issues)
+	}
+	for i, issue := range issues {
+		if issue.Error() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], issue)
+		}
+	}
+
+	issues = validateNotificationChannelTemplate("{\"url\": \"{{@alert_url\"}", monitorNotificationTemplateVariables)
+	if len(issues) != 1 || !strings.HasPrefix(issues[0].Error(), "template:1: ") {
+		t.Errorf("expected a syntax error, got %v", issues)
+	}
+}
+
+func TestNotificationChannelTemplateValidator(t *testing.T) {
+	v := notificationChannelTemplateValidator{product: "Monitor", variables: monitorNotificationTemplateVariables}
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("template"),
+		ConfigValue: types.StringValue(`{"alert": "{{@alert_nmae}}"}`),
+	}, resp)
+	if resp.Diagnostics.HasError() || len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity() != diag.SeverityWarning {
+		t.Fatalf("expected a warning, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.HasPrefix(detail, "1:14: unknown variable @alert_nmae. ") || !strings.Contains(detail, "@alert_name") {
+		t.Errorf("expected the warning to list the variables, got %s", detail)
+	}
+}
+
+func TestRenderNotificationChannelTemplate(t *testing.T) {
+	for template, expected := range map[string]string{
+		`{"alert": "{{@alert_name}}", "severity": "{{ @alert_severity }}", "other": "{{@unknown}}"}`: `{"alert": "High CPU usage", "severity": "high", "other": "{{@unknown}}"}`,
+		`{"priority": "{{ if eq @alert_severity "high" }}P1{{ else }}P3{{ end }}"}`:                  `{"priority": "P1"}`,
+		`{"alert": "{{ upper @alert_name }}", "severity": "{{@alert_severity}}"}`:                    `{"alert": "{{ upper @alert_name }}", "severity": "high"}`,
+	} {
+		if rendered := renderNotificationChannelTemplate(template, monitorNotificationTemplateVariables); rendered != expected {
+			t.Errorf("expected %s, got %s", expected, rendered)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package sysdig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// promqlVariable matches the variables of the dashboards, like $__interval, $namespace or ${namespace}
+var promqlVariable = regexp.MustCompile(`^\$(?:\{\w+\}|\w+)`)
+
+// promqlMatcherOperator matches the end of a label matcher, whose value a variable can be
+var promqlMatcherOperator = regexp.MustCompile(`(=|!=|=~|!~)\s*$`)
+
+// promqlLabelReference matches the references to the labels of the alerting series in the values
+// of the labels of an alert, like {{ $labels.kube_pod_name }} or {{kube_pod_name}}
+var promqlLabelReference = regexp.MustCompile(`\{\{\s*(?:\$labels\.)?(\w+)\s*\}\}`)
+
+func init() {
+	// the experimental functions and aggregators, like limitk or sort_by_label, are accepted so
+	// that the queries the API supports aren't rejected at plan time
+	parser.EnableExperimentalFunctions = true
+}
+
+// parsePromQL parses a query, replacing its variables by values of the same length so that
+// the positions of the errors are the ones in the query.
+func parsePromQL(query string) (parser.Expr, error) {
+	return parser.ParseExpr(replacePromQLVariables(query))
+}
+
+// replacePromQLVariables replaces the variables outside the quoted strings by a duration in the
+// ranges, a string in the label matchers and a number anywhere else.
+func replacePromQLVariables(query string) string {
+	var replaced strings.Builder
+	var quote byte
+	inRange := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(query) {
+				replaced.WriteByte(c)
+				i++
+				c = query[i]
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '[':
+			inRange = true
+		case c == ']':
+			inRange = false
+		case c == '$':
+			variable := promqlVariable.FindString(query[i:])
+			if variable == "" {
+				break
+			}
+			switch {
+			case inRange:
+				replaced.WriteString(strings.Repeat("1", len(variable)-1) + "s")
+			case promqlMatcherOperator.MatchString(query[:i]):
+				replaced.WriteString(`"` + strings.Repeat("_", len(variable)-2) + `"`)
+			default:
+				replaced.WriteString(strings.Repeat("1", len(variable)))
+			}
+			i += len(variable) - 1
+			continue
+		}
+		replaced.WriteByte(c)
+	}
+	return replaced.String()
+}
+
+// validatePromQL reports the syntax errors of a PromQL query, with their position in the query.
+func validatePromQL(i any, path cty.Path) diag.Diagnostics {
+	query, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %+v to be string", path)
+	}
+
+	_, err := parsePromQL(query)
+	if err == nil {
+		return nil
+	}
+
+	var parseErrors parser.ParseErrors
+	if !errors.As(err, &parseErrors) {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid PromQL query",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+	var diags diag.Diagnostics
+	for _, parseErr := range parseErrors {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "invalid PromQL query",
+			Detail:        fmt.Sprintf("%s: %s", parseErr.PositionRange.StartPosInput(query, 0), parseErr.Err),
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+// promqlOutputLabels returns the labels kept by a query aggregated by some labels, and false when
+// the labels of the result can't be known from the query only.
+func promqlOutputLabels(expr parser.Expr) ([]string, bool) {
+	switch e := expr.(type) {
+	case *parser.ParenExpr:
+		return promqlOutputLabels(e.Expr)
+	case *parser.BinaryExpr:
+		// the comparisons with a threshold keep the labels of the other side
+		if _, ok := e.RHS.(*parser.NumberLiteral); ok {
+			return promqlOutputLabels(e.LHS)
+		}
+		if _, ok := e.LHS.(*parser.NumberLiteral); ok {
+			return promqlOutputLabels(e.RHS)
+		}
+	case *parser.AggregateExpr:
+		switch e.Op {
+		case parser.TOPK, parser.BOTTOMK, parser.LIMITK, parser.LIMIT_RATIO:
+			// these keep the labels of the series they select
+			return nil, false
+		}
+		if !e.Without {
+			return e.Grouping, true
+		}
+	}
+	return nil, false
+}
+
+// validatePromQLLabels warns about the labels of group_by which aren't kept by the query, as the alert
+// would never be segmented by them, and about the labels referenced by the values of labels which
+// aren't kept by the query, as they would never be replaced.
+func validatePromQLLabels(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if !req.RawConfig.IsKnown() || req.RawConfig.IsNull() {
+		return
+	}
+	query := req.RawConfig.GetAttr("query")
+	if !query.IsKnown() || query.IsNull() {
+		return
+	}
+
+	expr, err := parsePromQL(query.AsString())
+	if err != nil {
+		// already reported by the validation of the query
+		return
+	}
+	labels, ok := promqlOutputLabels(expr)
+	if !ok {
+		return
+	}
+	unknownLabel := func(summary, consequence string, path cty.Path) {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       summary,
+			Detail:        fmt.Sprintf("the query only keeps the labels %s, so %s", strings.Join(labels, ", "), consequence),
+			AttributePath: path,
+		})
+	}
+
+	if req.RawConfig.Type().HasAttribute("group_by") {
+		groupBy := req.RawConfig.GetAttr("group_by")
+		if groupBy.IsKnown() && !groupBy.IsNull() {
+			for i, label := range groupBy.AsValueSlice() {
+				if !label.IsKnown() || label.IsNull() || slices.Contains(labels, label.AsString()) {
+					continue
+				}
+				unknownLabel("unknown label in group_by", "the alert can't be segmented by "+label.AsString(), cty.GetAttrPath("group_by").IndexInt(i))
+			}
+		}
+	}
+
+	alertLabels := req.RawConfig.GetAttr("labels")
+	if !alertLabels.IsKnown() || alertLabels.IsNull() {
+		return
+	}
+	values := alertLabels.AsValueMap()
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		value := values[key]
+		if !value.IsKnown() || value.IsNull() {
+			continue
+		}
+		for _, match := range promqlLabelReference.FindAllStringSubmatch(value.AsString(), -1) {
+			if slices.Contains(labels, match[1]) {
+				continue
+			}
+			unknownLabel("unknown label in labels", "the alert has no value for "+match[1], cty.GetAttrPath("labels").IndexString(key))
+		}
+	}
+}
@@ -0,0 +1,100 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidatePromQL(t *testing.T) {
+	for _, query := range []string{
+		"avg(avg_over_time(sysdig_host_cpu_used_percent[$__interval]))",
+		"sum(sysdig_container_cpu_used_percent{kube_namespace_name=$namespace})",
+		"avg(avg_over_time(sysdig_host_cpu_used_percent{ns_name=~${k8s_ns}}[$__range:$__interval]))",
+		`sysdig_container_cpu_used_percent{container_name="$not_a_variable"} > $threshold`,
+		`(elasticsearch_jvm_memory_used_bytes{area="heap"} / elasticsearch_jvm_memory_max_bytes{area="heap"}) * 100 > 80`,
+	} {
+		if diags := validatePromQL(query, cty.GetAttrPath("query")); diags.HasError() {
+			t.Errorf("expected %s to be valid, got %v", query, diags)
+		}
+	}
+
+	diags := validatePromQL("sum(rate(http_requests_total[5m])\n  by (code)", cty.GetAttrPath("query"))
+	if len(diags) == 0 || diags[0].Severity != diag.Error {
+		t.Fatalf("expected an error, got %v", diags)
+	}
+	if !strings.HasPrefix(diags[0].Detail, "2:3: ") {
+		t.Errorf("expected the error to be at the third column of the second line, got %s", diags[0].Detail)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("query")) {
+		t.Errorf("expected the error to be on the query, got %v", diags[0].AttributePath)
+	}
+}
+
+func TestValidatePromQLLabels(t *testing.T) {
+	config := func(query string, groupBy []string, labels map[string]string) cty.Value {
+		groupByValues := []cty.Value{}
+		for _, label := range groupBy {
+			groupByValues = append(groupByValues, cty.StringVal(label))
+		}
+		labelValues := cty.NullVal(cty.Map(cty.String))
+		if len(labels) > 0 {
+			values := map[string]cty.Value{}
+			for key, value := range labels {
+				values[key] = cty.StringVal(value)
+			}
+			labelValues = cty.MapVal(values)
+		}
+		attributes := map[string]cty.Value{
+			"query":  cty.StringVal(query),
+			"labels": labelValues,
+		}
+		if groupBy != nil {
+			attributes["group_by"] = cty.ListVal(groupByValues)
+		}
+		return cty.ObjectVal(attributes)
+	}
+
+	for _, c := range []struct {
+		config   cty.Value
+		warnings int
+	}{
+		{config("sum by (kube_cluster_name, kube_pod_name) (sysdig_container_cpu_used_percent) > 50", []string{"kube_cluster_name", "kube_pod_name"}, nil), 0},
+		{config("sum by (kube_cluster_name) (sysdig_container_cpu_used_percent) > 50", []string{"kube_cluster_name", "kube_pod_name"}, nil), 1},
+		{config("sum(sysdig_container_cpu_used_percent)", []string{"kube_pod_name"}, nil), 1},
+		{config("sum without (container_id) (sysdig_container_cpu_used_percent)", []string{"kube_pod_name"}, nil), 0},
+		{config("topk(5, sysdig_container_cpu_used_percent)", []string{"kube_pod_name"}, nil), 0},
+		{config("sysdig_container_cpu_used_percent", []string{"kube_pod_name"}, nil), 0},
+		{config("sum by (kube_pod_name) (sysdig_container_cpu_used_percent) > 50", nil, map[string]string{"pod": "{{ $labels.kube_pod_name }}", "team": "payments"}), 0},
+		{config("sum by (kube_pod_name) (sysdig_container_cpu_used_percent) > 50", nil, map[string]string{"cluster": "{{kube_cluster_name}}", "namespace": "{{ $labels.kube_namespace_name }}"}), 2},
+		{config("sysdig_container_cpu_used_percent > 50", nil, map[string]string{"cluster": "{{kube_cluster_name}}"}), 0},
+	} {
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		validatePromQLLabels(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: c.config}, resp)
+		if len(resp.Diagnostics) != c.warnings {
+			t.Errorf("expected %d warnings for %s, got %v", c.warnings, c.config.GetAttr("query").AsString(), resp.Diagnostics)
+		}
+		for _, d := range resp.Diagnostics {
+			if d.Severity != diag.Warning {
+				t.Errorf("expected a warning, got %v", d)
+			}
+		}
+	}
+}
+
+func TestValidatePromQL_ExperimentalFunctions(t *testing.T) {
+	for _, query := range []string{
+		"limitk(5, sysdig_container_cpu_used_percent)",
+		"sort_by_label(sysdig_container_cpu_used_percent, \"kube_pod_name\")",
+	} {
+		if diags := validatePromQL(query, cty.GetAttrPath("query")); diags.HasError() {
+			t.Errorf("expected %s to be valid, got %v", query, diags)
+		}
+	}
+}
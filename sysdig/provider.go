@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type SysdigProvider struct {
@@ -32,6 +33,37 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_API_TOKEN", nil),
 			},
+			"sysdig_secure_api_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_API_TOKEN_FILE", nil),
+			},
+			"sysdig_secure_api_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_API_TOKEN_COMMAND", nil),
+			},
+			"sysdig_secure_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_CLIENT_ID", nil),
+			},
+			"sysdig_secure_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_CLIENT_SECRET", nil),
+			},
+			"sysdig_secure_token_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_TOKEN_URL", nil),
+			},
+			"sysdig_secure_token_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_TOKEN_SCOPE", nil),
+			},
 			"sysdig_secure_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -42,12 +74,77 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_INSECURE_TLS", false),
 			},
+			"sysdig_secure_ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_CA_CERTIFICATE", nil),
+			},
+			"sysdig_secure_client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_CLIENT_CERTIFICATE", nil),
+			},
+			"sysdig_secure_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_SECURE_CLIENT_KEY", nil),
+			},
+			"sysdig_secure_https_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_SECURE_HTTPS_PROXY", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"sysdig_secure_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_SECURE_REQUESTS_PER_SECOND", nil),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"sysdig_secure_max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_SECURE_MAX_CONCURRENT_REQUESTS", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"sysdig_monitor_api_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_API_TOKEN", nil),
 			},
+			"sysdig_monitor_api_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_API_TOKEN_FILE", nil),
+			},
+			"sysdig_monitor_api_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_API_TOKEN_COMMAND", nil),
+			},
+			"sysdig_monitor_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_CLIENT_ID", nil),
+			},
+			"sysdig_monitor_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_CLIENT_SECRET", nil),
+			},
+			"sysdig_monitor_token_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_TOKEN_URL", nil),
+			},
+			"sysdig_monitor_token_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_TOKEN_SCOPE", nil),
+			},
 			"sysdig_monitor_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -58,11 +155,86 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_INSECURE_TLS", false),
 			},
+			"sysdig_monitor_ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_CA_CERTIFICATE", nil),
+			},
+			"sysdig_monitor_client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_CLIENT_CERTIFICATE", nil),
+			},
+			"sysdig_monitor_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_MONITOR_CLIENT_KEY", nil),
+			},
+			"sysdig_monitor_https_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_MONITOR_HTTPS_PROXY", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"sysdig_monitor_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_MONITOR_REQUESTS_PER_SECOND", nil),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"sysdig_monitor_max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_MONITOR_MAX_CONCURRENT_REQUESTS", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"extra_headers": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"disable_body_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_DISABLE_BODY_LOGGING", false),
+			},
+			"enable_read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_ENABLE_READ_CACHE", false),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_MAX_RETRIES", 5),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_RETRY_WAIT_MIN", nil),
+				ValidateFunc: validateDuration,
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SYSDIG_RETRY_WAIT_MAX", nil),
+				ValidateFunc: validateDuration,
+			},
+			"retryable_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(100, 599),
+				},
+			},
+			"retry_non_idempotent_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SYSDIG_RETRY_NON_IDEMPOTENT_REQUESTS", true),
+			},
 			"sysdig_monitor_team_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -129,31 +301,22 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_team_service_account":       resourceSysdigTeamServiceAccount(),
 			"sysdig_user":                       resourceSysdigUser(),
 
-			"sysdig_monitor_alert_v2_change":                               resourceSysdigMonitorAlertV2Change(),
-			"sysdig_monitor_alert_v2_downtime":                             resourceSysdigMonitorAlertV2Downtime(),
-			"sysdig_monitor_alert_v2_event":                                resourceSysdigMonitorAlertV2Event(),
-			"sysdig_monitor_alert_v2_form_based_prometheus":                resourceSysdigMonitorAlertV2FormBasedPrometheus(),
-			"sysdig_monitor_alert_v2_group_outlier":                        resourceSysdigMonitorAlertV2GroupOutlier(),
-			"sysdig_monitor_alert_v2_metric":                               resourceSysdigMonitorAlertV2Metric(),
-			"sysdig_monitor_alert_v2_prometheus":                           resourceSysdigMonitorAlertV2Prometheus(),
-			"sysdig_monitor_cloud_account":                                 resourceSysdigMonitorCloudAccount(),
-			"sysdig_monitor_dashboard":                                     resourceSysdigMonitorDashboard(),
-			"sysdig_monitor_inhibition_rule":                               resourceSysdigMonitorInhibitionRule(),
-			"sysdig_monitor_notification_channel_custom_webhook":           resourceSysdigMonitorNotificationChannelCustomWebhook(),
-			"sysdig_monitor_notification_channel_email":                    resourceSysdigMonitorNotificationChannelEmail(),
-			"sysdig_monitor_notification_channel_google_chat":              resourceSysdigMonitorNotificationChannelGoogleChat(),
-			"sysdig_monitor_notification_channel_ibm_event_notification":   resourceSysdigMonitorNotificationChannelIBMEventNotification(),
-			"sysdig_monitor_notification_channel_msteams":                  resourceSysdigMonitorNotificationChannelMSTeams(),
-			"sysdig_monitor_notification_channel_opsgenie":                 resourceSysdigMonitorNotificationChannelOpsGenie(),
-			"sysdig_monitor_notification_channel_pagerduty":                resourceSysdigMonitorNotificationChannelPagerduty(),
-			"sysdig_monitor_notification_channel_prometheus_alert_manager": resourceSysdigMonitorNotificationChannelPrometheusAlertManager(),
-			"sysdig_monitor_notification_channel_slack":                    resourceSysdigMonitorNotificationChannelSlack(),
-			"sysdig_monitor_notification_channel_sns":                      resourceSysdigMonitorNotificationChannelSNS(),
-			"sysdig_monitor_notification_channel_team_email":               resourceSysdigMonitorNotificationChannelTeamEmail(),
-			"sysdig_monitor_notification_channel_victorops":                resourceSysdigMonitorNotificationChannelVictorOps(),
-			"sysdig_monitor_notification_channel_webhook":                  resourceSysdigMonitorNotificationChannelWebhook(),
-			"sysdig_monitor_silence_rule":                                  resourceSysdigMonitorSilenceRule(),
-			"sysdig_monitor_team":                                          resourceSysdigMonitorTeam(),
+			"sysdig_monitor_alert_v2_anomaly":               resourceSysdigMonitorAlertV2Anomaly(),
+			"sysdig_monitor_alert_v2_change":                resourceSysdigMonitorAlertV2Change(),
+			"sysdig_monitor_alert_v2_downtime":              resourceSysdigMonitorAlertV2Downtime(),
+			"sysdig_monitor_alert_v2_event":                 resourceSysdigMonitorAlertV2Event(),
+			"sysdig_monitor_alert_v2_form_based_prometheus": resourceSysdigMonitorAlertV2FormBasedPrometheus(),
+			"sysdig_monitor_alert_v2_group_outlier":         resourceSysdigMonitorAlertV2GroupOutlier(),
+			"sysdig_monitor_alert_v2_metric":                resourceSysdigMonitorAlertV2Metric(),
+			"sysdig_monitor_alert_v2_prometheus":            resourceSysdigMonitorAlertV2Prometheus(),
+			"sysdig_monitor_cloud_account":                  resourceSysdigMonitorCloudAccount(),
+			"sysdig_monitor_dashboard":                      resourceSysdigMonitorDashboard(),
+			"sysdig_monitor_dashboard_folder":               resourceSysdigMonitorDashboardFolder(),
+			"sysdig_monitor_dashboard_json":                 resourceSysdigMonitorDashboardJSON(),
+			"sysdig_monitor_inhibition_rule":                resourceSysdigMonitorInhibitionRule(),
+			"sysdig_monitor_notification_route":             resourceSysdigMonitorNotificationRoute(),
+			"sysdig_monitor_silence_rule":                   resourceSysdigMonitorSilenceRule(),
+			"sysdig_monitor_team":                           resourceSysdigMonitorTeam(),
 
 			"sysdig_secure_aws_ml_policy":                                 resourceSysdigSecureAWSMLPolicy(),
 			"sysdig_secure_okta_ml_policy":                                resourceSysdigSecureOktaMLPolicy(),
@@ -169,14 +332,17 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_secure_managed_ruleset":                               resourceSysdigSecureManagedRuleset(),
 			"sysdig_secure_ml_policy":                                     resourceSysdigSecureMLPolicy(),
 			"sysdig_secure_notification_channel_email":                    resourceSysdigSecureNotificationChannelEmail(),
+			"sysdig_secure_notification_channel_jira":                     resourceSysdigSecureNotificationChannelJira(),
 			"sysdig_secure_notification_channel_msteams":                  resourceSysdigSecureNotificationChannelMSTeams(),
 			"sysdig_secure_notification_channel_opsgenie":                 resourceSysdigSecureNotificationChannelOpsGenie(),
 			"sysdig_secure_notification_channel_pagerduty":                resourceSysdigSecureNotificationChannelPagerduty(),
 			"sysdig_secure_notification_channel_prometheus_alert_manager": resourceSysdigSecureNotificationChannelPrometheusAlertManager(),
+			"sysdig_secure_notification_channel_servicenow":               resourceSysdigSecureNotificationChannelServiceNow(),
 			"sysdig_secure_notification_channel_slack":                    resourceSysdigSecureNotificationChannelSlack(),
 			"sysdig_secure_notification_channel_sns":                      resourceSysdigSecureNotificationChannelSNS(),
 			"sysdig_secure_notification_channel_team_email":               resourceSysdigSecureNotificationChannelTeamEmail(),
 			"sysdig_secure_notification_channel_victorops":                resourceSysdigSecureNotificationChannelVictorOps(),
+			"sysdig_secure_notification_channel_webex":                    resourceSysdigSecureNotificationChannelWebex(),
 			"sysdig_secure_notification_channel_webhook":                  resourceSysdigSecureNotificationChannelWebhook(),
 			"sysdig_secure_organization":                                  resourceSysdigSecureOrganization(),
 			"sysdig_secure_posture_accept_risk":                           resourceSysdigSecureAcceptPostureRisk(),
@@ -203,21 +369,28 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_custom_role":            dataSourceSysdigCustomRole(),
 			"sysdig_builtin_role":           dataSourceSysdigBuiltinRole(),
 			"sysdig_fargate_workload_agent": dataSourceSysdigFargateWorkloadAgent(),
+			"sysdig_notification_channels":  dataSourceSysdigNotificationChannels(),
 			"sysdig_user":                   dataSourceSysdigUser(),
 
+			"sysdig_monitor_alert_v2":                                      dataSourceSysdigMonitorAlertV2(),
+			"sysdig_monitor_alerts_v2":                                     dataSourceSysdigMonitorAlertsV2(),
 			"sysdig_monitor_custom_role_permissions":                       dataSourceSysdigMonitorCustomRolePermissions(),
+			"sysdig_monitor_legacy_alert":                                  dataSourceSysdigMonitorLegacyAlert(),
 			"sysdig_monitor_notification_channel_custom_webhook":           dataSourceSysdigMonitorNotificationChannelCustomWebhook(),
 			"sysdig_monitor_notification_channel_email":                    dataSourceSysdigMonitorNotificationChannelEmail(),
 			"sysdig_monitor_notification_channel_google_chat":              dataSourceSysdigMonitorNotificationChannelGoogleChat(),
 			"sysdig_monitor_notification_channel_ibm_event_notification":   dataSourceSysdigMonitorNotificationChannelIBMEventNotification(),
+			"sysdig_monitor_notification_channel_jira":                     dataSourceSysdigMonitorNotificationChannelJira(),
 			"sysdig_monitor_notification_channel_msteams":                  dataSourceSysdigMonitorNotificationChannelMSTeams(),
 			"sysdig_monitor_notification_channel_opsgenie":                 dataSourceSysdigMonitorNotificationChannelOpsGenie(),
 			"sysdig_monitor_notification_channel_pagerduty":                dataSourceSysdigMonitorNotificationChannelPagerduty(),
 			"sysdig_monitor_notification_channel_prometheus_alert_manager": dataSourceSysdigMonitorNotificationChannelPrometheusAlertManager(),
+			"sysdig_monitor_notification_channel_servicenow":               dataSourceSysdigMonitorNotificationChannelServiceNow(),
 			"sysdig_monitor_notification_channel_slack":                    dataSourceSysdigMonitorNotificationChannelSlack(),
 			"sysdig_monitor_notification_channel_sns":                      dataSourceSysdigMonitorNotificationChannelSNS(),
 			"sysdig_monitor_notification_channel_team_email":               dataSourceSysdigMonitorNotificationChannelTeamEmail(),
 			"sysdig_monitor_notification_channel_victorops":                dataSourceSysdigMonitorNotificationChannelVictorOps(),
+			"sysdig_monitor_notification_channel_webex":                    dataSourceSysdigMonitorNotificationChannelWebex(),
 			"sysdig_monitor_notification_channel_webhook":                  dataSourceSysdigMonitorNotificationChannelWebhook(),
 			"sysdig_monitor_team":                                          dataSourceSysdigMonitorTeam(),
 			"sysdig_monitor_teams":                                         dataSourceSysdigMonitorTeams(),
@@ -235,14 +408,17 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_secure_managed_ruleset":                               dataSourceSysdigSecureManagedRuleset(),
 			"sysdig_secure_ml_policy":                                     dataSourceSysdigSecureMLPolicy(),
 			"sysdig_secure_notification_channel_email":                    dataSourceSysdigSecureNotificationChannelEmail(),
+			"sysdig_secure_notification_channel_jira":                     dataSourceSysdigSecureNotificationChannelJira(),
 			"sysdig_secure_notification_channel_msteams":                  dataSourceSysdigSecureNotificationChannelMSTeams(),
 			"sysdig_secure_notification_channel_opsgenie":                 dataSourceSysdigSecureNotificationChannelOpsGenie(),
 			"sysdig_secure_notification_channel_pagerduty":                dataSourceSysdigSecureNotificationChannelPagerduty(),
 			"sysdig_secure_notification_channel_prometheus_alert_manager": dataSourceSysdigSecureNotificationChannelPrometheusAlertManager(),
+			"sysdig_secure_notification_channel_servicenow":               dataSourceSysdigSecureNotificationChannelServiceNow(),
 			"sysdig_secure_notification_channel_slack":                    dataSourceSysdigSecureNotificationChannelSlack(),
 			"sysdig_secure_notification_channel_sns":                      dataSourceSysdigSecureNotificationChannelSNS(),
 			"sysdig_secure_notification_channel_team_email":               dataSourceSysdigSecureNotificationChannelTeamEmail(),
 			"sysdig_secure_notification_channel_victorops":                dataSourceSysdigSecureNotificationChannelVictorOps(),
+			"sysdig_secure_notification_channel_webex":                    dataSourceSysdigSecureNotificationChannelWebex(),
 			"sysdig_secure_notification_channel_webhook":                  dataSourceSysdigSecureNotificationChannelWebhook(),
 			"sysdig_secure_posture_policies":                              dataSourceSysdigSecurePosturePolicies(),
 			"sysdig_secure_posture_policy":                                dataSourceSysdigSecurePosturePolicy(),
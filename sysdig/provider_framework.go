@@ -0,0 +1,133 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SysdigFrameworkProvider is the terraform-plugin-framework counterpart of SysdigProvider.
+// Both are served together through a muxed server and share the same SysdigClients,
+// so resources implemented on either side are configured by the same provider block.
+type SysdigFrameworkProvider struct {
+	SysdigClient SysdigClients
+}
+
+var _ provider.Provider = &SysdigFrameworkProvider{}
+
+func (p *SysdigFrameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "sysdig"
+}
+
+// Schema mirrors the SDKv2 provider schema, since the muxed server requires every
+// underlying provider to expose an identical provider configuration.
+func (p *SysdigFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes, err := frameworkProviderAttributes((&SysdigProvider{}).Provider().Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build provider schema", err.Error())
+		return
+	}
+
+	resp.Schema = schema.Schema{Attributes: attributes}
+}
+
+// Configure does not read the configuration itself: the SDKv2 provider configures the
+// shared SysdigClients, which lazily builds the API clients on first use.
+func (p *SysdigFrameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ResourceData = p.SysdigClient
+	resp.DataSourceData = p.SysdigClient
+}
+
+func (p *SysdigFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newMonitorNotificationChannelCustomWebhookResource,
+		newMonitorNotificationChannelEmailResource,
+		newMonitorNotificationChannelGoogleChatResource,
+		newMonitorNotificationChannelIBMEventNotificationResource,
+		newMonitorNotificationChannelJiraResource,
+		newMonitorNotificationChannelMSTeamsResource,
+		newMonitorNotificationChannelOpsGenieResource,
+		newMonitorNotificationChannelPagerdutyResource,
+		newMonitorNotificationChannelPrometheusAlertManagerResource,
+		newMonitorNotificationChannelServiceNowResource,
+		newMonitorNotificationChannelSlackResource,
+		newMonitorNotificationChannelSNSResource,
+		newMonitorNotificationChannelTeamEmailResource,
+		newMonitorNotificationChannelTestResource,
+		newMonitorNotificationChannelVictorOpsResource,
+		newMonitorNotificationChannelWebexResource,
+		newMonitorNotificationChannelWebhookResource,
+		newNotificationChannelResource,
+		newSecureNotificationChannelTestResource,
+	}
+}
+
+func (p *SysdigFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func frameworkProviderAttributes(sdkSchema map[string]*sdkschema.Schema) (map[string]schema.Attribute, error) {
+	attributes := make(map[string]schema.Attribute, len(sdkSchema))
+	for _, name := range slices.Sorted(maps.Keys(sdkSchema)) {
+		s := sdkSchema[name]
+		switch s.Type {
+		case sdkschema.TypeString:
+			attributes[name] = schema.StringAttribute{Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+		case sdkschema.TypeBool:
+			attributes[name] = schema.BoolAttribute{Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+		case sdkschema.TypeInt:
+			attributes[name] = schema.Int64Attribute{Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+		case sdkschema.TypeFloat:
+			attributes[name] = schema.Float64Attribute{Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+		case sdkschema.TypeMap, sdkschema.TypeList, sdkschema.TypeSet:
+			elemType, err := frameworkPrimitiveElemType(s.Elem)
+			if err != nil {
+				return nil, fmt.Errorf("provider attribute %s: %w", name, err)
+			}
+			switch s.Type {
+			case sdkschema.TypeMap:
+				attributes[name] = schema.MapAttribute{ElementType: elemType, Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+			case sdkschema.TypeList:
+				attributes[name] = schema.ListAttribute{ElementType: elemType, Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+			default:
+				attributes[name] = schema.SetAttribute{ElementType: elemType, Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive}
+			}
+		default:
+			return nil, fmt.Errorf("provider attribute %s: unsupported type %s", name, s.Type)
+		}
+	}
+	return attributes, nil
+}
+
+func frameworkPrimitiveElemType(elem any) (attr.Type, error) {
+	if elem == nil {
+		return types.StringType, nil
+	}
+
+	s, ok := elem.(*sdkschema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("unsupported element %T", elem)
+	}
+
+	switch s.Type {
+	case sdkschema.TypeString:
+		return types.StringType, nil
+	case sdkschema.TypeBool:
+		return types.BoolType, nil
+	case sdkschema.TypeInt:
+		return types.Int64Type, nil
+	case sdkschema.TypeFloat:
+		return types.Float64Type, nil
+	default:
+		return nil, fmt.Errorf("unsupported element type %s", s.Type)
+	}
+}
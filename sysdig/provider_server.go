@@ -0,0 +1,26 @@
+package sysdig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// ProtoV5ProviderServerFactory muxes the SDKv2 and the plugin framework providers into a
+// single protocol 5 server. Both providers share the given SysdigClients.
+func ProtoV5ProviderServerFactory(ctx context.Context, sysdigClient SysdigClients) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := &SysdigProvider{SysdigClient: sysdigClient}
+	frameworkProvider := &SysdigFrameworkProvider{SysdigClient: sysdigClient}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.Provider().GRPCProvider,
+		providerserver.NewProtocol5(frameworkProvider),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -0,0 +1,41 @@
+package sysdig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	ctx := context.Background()
+
+	serverFactory, err := ProtoV5ProviderServerFactory(ctx, NewSysdigClients())
+	require.NoError(t, err)
+
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	// served by the plugin framework provider
+	assert.Contains(t, resp.ResourceSchemas, "sysdig_monitor_notification_channel_email")
+	assert.Contains(t, resp.ResourceSchemas, "sysdig_monitor_notification_channel_slack")
+	// served by the SDKv2 provider
+	assert.Contains(t, resp.ResourceSchemas, "sysdig_secure_notification_channel_email")
+	assert.Contains(t, resp.DataSourceSchemas, "sysdig_monitor_notification_channel_email")
+
+	// team scoped resources of both providers expose the team override
+	for _, name := range []string{"sysdig_monitor_notification_channel_email", "sysdig_secure_notification_channel_email", "sysdig_monitor_dashboard"} {
+		attributes := map[string]bool{}
+		for _, attribute := range resp.ResourceSchemas[name].Block.Attributes {
+			attributes[attribute.Name] = true
+		}
+		assert.True(t, attributes["team_id"], "%s is missing team_id", name)
+		assert.True(t, attributes["team_name"], "%s is missing team_name", name)
+	}
+}
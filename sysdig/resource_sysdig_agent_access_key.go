@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -158,9 +157,9 @@ func resourceSysdigAgentAccessKeyRead(ctx context.Context, d *schema.ResourceDat
 
 	agentKeyID := d.Id()
 
-	agentAccessKey, statusCode, err := client.GetAgentAccessKeyByID(ctx, agentKeyID)
+	agentAccessKey, err := client.GetAgentAccessKeyByID(ctx, agentKeyID)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
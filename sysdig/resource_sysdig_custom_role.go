@@ -77,7 +77,7 @@ func resourceSysdigCustomRoleRead(ctx context.Context, d *schema.ResourceData, m
 
 	customRole, err := client.GetCustomRoleByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrCustomRoleNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
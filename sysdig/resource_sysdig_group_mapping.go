@@ -84,7 +84,7 @@ func resourceSysdigGroupMappingRead(ctx context.Context, d *schema.ResourceData,
 
 	groupMapping, err := client.GetGroupMapping(ctx, id)
 	if err != nil {
-		if err == v2.ErrGroupMappingNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -47,7 +47,7 @@ func resourceSysdigGroupMappingConfigRead(ctx context.Context, d *schema.Resourc
 
 	groupMappingConfig, err := client.GetGroupMappingConfig(ctx)
 	if err != nil {
-		if err == v2.ErrGroupMappingConfigNotFound {
+		if v2.IsNotFound(err) {
 			return nil
 		}
 		return diag.FromErr(err)
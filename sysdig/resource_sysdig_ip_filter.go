@@ -3,6 +3,7 @@ package sysdig
 import (
 	"context"
 	"strconv"
+	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,11 +11,20 @@ import (
 )
 
 func resourceSysdigIPFilter() *schema.Resource {
+	timeout := 5 * time.Minute
+
 	return &schema.Resource{
 		ReadContext:   resourceSysdigIPFilterRead,
 		CreateContext: resourceSysdigIPFilterCreate,
 		UpdateContext: resourceSysdigIPFilterUpdate,
 		DeleteContext: resourceSysdigIPFilterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ip_range": {
 				Type:     schema.TypeString,
@@ -45,7 +55,7 @@ func resourceSysdigIPFilterRead(ctx context.Context, d *schema.ResourceData, m a
 
 	ipFilter, err := client.GetIPFilterByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrIPFilterNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -2,6 +2,7 @@ package sysdig
 
 import (
 	"context"
+	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -9,11 +10,20 @@ import (
 )
 
 func resourceSysdigIPFilteringSettings() *schema.Resource {
+	timeout := 5 * time.Minute
+
 	return &schema.Resource{
 		ReadContext:   resourceSysdigIPFilteringSettingsRead,
 		CreateContext: resourceSysdigIPFilteringSettingsCreate,
 		UpdateContext: resourceSysdigIPFilteringSettingsUpdate,
 		DeleteContext: resourceSysdigIPFilteringSettingsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ip_filtering_enabled": {
 				Type:     schema.TypeBool,
@@ -0,0 +1,249 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSysdigMonitorAlertV2Anomaly() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	resource := &schema.Resource{
+		CreateContext: resourceSysdigMonitorAlertV2AnomalyCreate,
+		UpdateContext: resourceSysdigMonitorAlertV2AnomalyUpdate,
+		ReadContext:   resourceSysdigMonitorAlertV2AnomalyRead,
+		DeleteContext: resourceSysdigMonitorAlertV2AnomalyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
+			"observation_window_minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(10),
+			},
+			"sensitivity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MEDIUM",
+				ValidateFunc: validation.StringInSlice([]string{"LOW", "MEDIUM", "HIGH"}, false),
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "BOTH",
+				ValidateFunc: validation.StringInSlice([]string{"ABOVE", "BELOW", "BOTH"}, false),
+			},
+			"metric": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"avg", "timeAvg", "sum", "min", "max"}, false),
+			},
+			"group_aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"avg", "sum", "min", "max"}, false),
+			},
+			"no_data_behaviour": createAlertV2NoDataBehaviourSchema(v2.AlertV2NoDataBehaviourDoNothing, v2.AlertV2NoDataBehaviourTrigger),
+			"unreported_alert_notifications_retention_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(60),
+			},
+		})),
+	}
+
+	return teamScopedResource(resource)
+}
+
+func getAlertV2AnomalyClient(c SysdigClients) (v2.AlertV2AnomalyInterface, error) {
+	return getAlertV2Client(c)
+}
+
+func resourceSysdigMonitorAlertV2AnomalyCreate(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2AnomalyClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := buildAlertV2AnomalyStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	aCreated, err := client.CreateAlertV2Anomaly(ctx, *a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(aCreated.ID))
+
+	err = updateAlertV2AnomalyState(d, &aCreated)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorAlertV2AnomalyRead(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2AnomalyClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := client.GetAlertV2AnomalyByID(ctx, id)
+	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = updateAlertV2AnomalyState(d, &a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorAlertV2AnomalyUpdate(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2AnomalyClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := buildAlertV2AnomalyStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a.ID, _ = strconv.Atoi(d.Id())
+
+	aUpdated, err := client.UpdateAlertV2Anomaly(ctx, *a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateAlertV2AnomalyState(d, &aUpdated)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorAlertV2AnomalyDelete(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2AnomalyClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteAlertV2Anomaly(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func buildAlertV2AnomalyStruct(d *schema.ResourceData) (*v2.AlertV2Anomaly, error) {
+	alertV2Common := buildAlertV2CommonStruct(d)
+	alertV2Common.Type = string(v2.AlertV2TypeAnomaly)
+	config := v2.AlertV2ConfigAnomaly{}
+
+	buildScopedSegmentedConfigStruct(d, &config.ScopedSegmentedConfig)
+
+	config.Sensitivity = d.Get("sensitivity").(string)
+
+	config.Direction = d.Get("direction").(string)
+
+	metric := d.Get("metric").(string)
+	config.Metric.ID = metric
+
+	config.TimeAggregation = d.Get("time_aggregation").(string)
+
+	config.GroupAggregation = d.Get("group_aggregation").(string)
+
+	config.NoDataBehaviour = d.Get("no_data_behaviour").(string)
+
+	config.ObservationWindow = minutesToSeconds(d.Get("observation_window_minutes").(int))
+
+	var unreportedAlertNotificationsRetentionSec *int
+	if unreportedAlertNotificationsRetentionSecInterface, ok := d.GetOk("unreported_alert_notifications_retention_seconds"); ok {
+		u := unreportedAlertNotificationsRetentionSecInterface.(int)
+		unreportedAlertNotificationsRetentionSec = &u
+	}
+
+	alert := &v2.AlertV2Anomaly{
+		AlertV2Common:                            *alertV2Common,
+		Config:                                   config,
+		UnreportedAlertNotificationsRetentionSec: unreportedAlertNotificationsRetentionSec,
+	}
+	return alert, nil
+}
+
+func updateAlertV2AnomalyState(d *schema.ResourceData, alert *v2.AlertV2Anomaly) error {
+	err := updateAlertV2CommonState(d, &alert.AlertV2Common)
+	if err != nil {
+		return err
+	}
+
+	err = updateScopedSegmentedConfigState(d, &alert.Config.ScopedSegmentedConfig)
+	if err != nil {
+		return err
+	}
+
+	_ = d.Set("observation_window_minutes", secondsToMinutes(alert.Config.ObservationWindow))
+
+	_ = d.Set("sensitivity", alert.Config.Sensitivity)
+
+	_ = d.Set("direction", alert.Config.Direction)
+
+	_ = d.Set("metric", alert.Config.Metric.ID)
+
+	_ = d.Set("time_aggregation", alert.Config.TimeAggregation)
+
+	_ = d.Set("group_aggregation", alert.Config.GroupAggregation)
+
+	_ = d.Set("no_data_behaviour", alert.Config.NoDataBehaviour)
+
+	if alert.UnreportedAlertNotificationsRetentionSec != nil {
+		_ = d.Set("unreported_alert_notifications_retention_seconds", *alert.UnreportedAlertNotificationsRetentionSec)
+	} else {
+		_ = d.Set("unreported_alert_notifications_retention_seconds", nil)
+	}
+
+	return nil
+}
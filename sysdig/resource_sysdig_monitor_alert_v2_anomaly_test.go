@@ -0,0 +1,112 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlertV2Anomaly(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: alertV2Anomaly(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_alert_v2_anomaly.sample", "sensitivity", "MEDIUM"),
+					resource.TestCheckResourceAttr("sysdig_monitor_alert_v2_anomaly.sample", "direction", "BOTH"),
+					resource.TestCheckResourceAttr("sysdig_monitor_alert_v2_anomaly.sample", "no_data_behaviour", "DO_NOTHING"),
+				),
+			},
+			{
+				Config: alertV2AnomalyWithSensitivity(rText()),
+			},
+			{
+				Config: alertV2AnomalyWithNoData(rText()),
+			},
+			{
+				Config:      alertV2AnomalyWithInvalidNoData(rText()),
+				ExpectError: regexp.MustCompile(`expected no_data_behaviour to be one of`),
+			},
+			{
+				ResourceName:      "sysdig_monitor_alert_v2_anomaly.sample",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func alertV2Anomaly(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_anomaly" "sample" {
+
+	name = "TERRAFORM TEST - ANOMALY %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	observation_window_minutes = 15
+
+}
+`, name)
+}
+
+func alertV2AnomalyWithSensitivity(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_anomaly" "sample" {
+
+	name = "TERRAFORM TEST - ANOMALY %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	group_by = ["kube_cluster_name"]
+	scope {
+		label = "kube_cluster_name"
+		operator = "in"
+		values = ["thom-cluster1", "demo-env-prom"]
+	}
+	observation_window_minutes = 30
+	sensitivity = "HIGH"
+	direction = "ABOVE"
+
+}
+`, name)
+}
+
+func alertV2AnomalyWithNoData(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_anomaly" "sample" {
+
+	name = "TERRAFORM TEST - ANOMALY %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	observation_window_minutes = 15
+	no_data_behaviour = "TRIGGER"
+
+}
+`, name)
+}
+
+func alertV2AnomalyWithInvalidNoData(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_anomaly" "sample" {
+
+	name = "TERRAFORM TEST - ANOMALY %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	observation_window_minutes = 15
+	no_data_behaviour = "RESOLVE"
+
+}
+`, name)
+}
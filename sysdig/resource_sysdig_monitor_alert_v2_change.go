@@ -74,7 +74,7 @@ var allowedTimeRanges = map[int]map[int]struct{}{ // for each shorter time range
 func resourceSysdigMonitorAlertV2Change() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorAlertV2ChangeCreate,
 		UpdateContext: resourceSysdigMonitorAlertV2ChangeUpdate,
 		ReadContext:   resourceSysdigMonitorAlertV2ChangeRead,
@@ -127,6 +127,7 @@ func resourceSysdigMonitorAlertV2Change() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"no_data_behaviour": createAlertV2NoDataBehaviourSchema(v2.AlertV2NoDataBehaviourDoNothing, v2.AlertV2NoDataBehaviourTrigger),
 			"unreported_alert_notifications_retention_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -158,7 +159,7 @@ func resourceSysdigMonitorAlertV2Change() *schema.Resource {
 
 			return nil
 		},
-	}
+	})
 }
 
 func getAlertV2ChangeClient(c SysdigClients) (v2.AlertV2ChangeInterface, error) {
@@ -204,7 +205,7 @@ func resourceSysdigMonitorAlertV2ChangeRead(ctx context.Context, d *schema.Resou
 
 	a, err := client.GetAlertV2ChangeByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -304,6 +305,8 @@ func buildAlertV2ChangeStruct(d *schema.ResourceData) (*v2.AlertV2Change, error)
 	// LongerRangeSec
 	config.LongerRangeSec = d.Get("longer_time_range_seconds").(int)
 
+	config.NoDataBehaviour = d.Get("no_data_behaviour").(string)
+
 	var unreportedAlertNotificationsRetentionSec *int
 	if unreportedAlertNotificationsRetentionSecInterface, ok := d.GetOk("unreported_alert_notifications_retention_seconds"); ok {
 		u := unreportedAlertNotificationsRetentionSecInterface.(int)
@@ -347,6 +350,8 @@ func updateAlertV2ChangeState(d *schema.ResourceData, alert *v2.AlertV2Change) e
 
 	_ = d.Set("longer_time_range_seconds", alert.Config.LongerRangeSec)
 
+	_ = d.Set("no_data_behaviour", alert.Config.NoDataBehaviour)
+
 	if alert.UnreportedAlertNotificationsRetentionSec != nil {
 		_ = d.Set("unreported_alert_notifications_retention_seconds", *alert.UnreportedAlertNotificationsRetentionSec)
 	} else {
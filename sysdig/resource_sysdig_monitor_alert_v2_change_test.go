@@ -8,21 +8,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 func TestAccAlertV2Change(t *testing.T) {
 	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: alertV2Change(rText()),
@@ -30,6 +23,12 @@ func TestAccAlertV2Change(t *testing.T) {
 			{
 				Config: alertV2ChangeWithScope(rText()),
 			},
+			{
+				Config: alertV2ChangeWithNoData(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_alert_v2_change.sample", "no_data_behaviour", "TRIGGER"),
+				),
+			},
 			{
 				Config: alertV2ChangeWithNotificationChannels(rText()),
 			},
@@ -121,6 +120,22 @@ resource "sysdig_monitor_alert_v2_change" "sample" {
 	`, name)
 }
 
+func alertV2ChangeWithNoData(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_change" "sample" {
+	name = "TERRAFORM TEST - CHANGE %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	shorter_time_range_seconds = 300
+	longer_time_range_seconds = 3600
+	no_data_behaviour = "TRIGGER"
+}
+`, name)
+}
+
 func alertV2ChangeWithNotificationChannels(name string) string {
 	return fmt.Sprintf(`
 resource "sysdig_monitor_notification_channel_email" "nc_email1" {
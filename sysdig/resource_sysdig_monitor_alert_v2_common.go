@@ -64,43 +64,7 @@ func createAlertV2Schema(original map[string]*schema.Schema) map[string]*schema.
 			Type:     schema.TypeInt,
 			Computed: true,
 		},
-		"notification_channels": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"id": {
-						Type:     schema.TypeInt,
-						Required: true,
-					},
-					"renotify_every_minutes": {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  0,
-					},
-					"notify_on_resolve": {
-						Type:     schema.TypeBool,
-						Optional: true,
-						Default:  true,
-					},
-					"notify_on_acknowledge": {
-						Type:         schema.TypeString,
-						Optional:     true,
-						ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
-					},
-					"main_threshold": {
-						Type:     schema.TypeBool,
-						Optional: true,
-						Default:  true,
-					},
-					"warning_threshold": {
-						Type:     schema.TypeBool,
-						Optional: true,
-						Default:  false,
-					},
-				},
-			},
-		},
+		"notification_channels": createAlertV2NotificationChannelsSchema(),
 		"custom_notification": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -204,6 +168,141 @@ func createAlertV2Schema(original map[string]*schema.Schema) map[string]*schema.
 	return alertSchema
 }
 
+// createAlertV2NoDataBehaviourSchema returns the no_data_behaviour argument, accepting only the behaviours
+// supported by the alert type. The alerts evaluated on the values of a metric have it, the others don't:
+// a Prometheus alert has no series to evaluate without data, an event alert counts zero events, and a
+// downtime alert is itself the alert on the entities which stop reporting data.
+func createAlertV2NoDataBehaviourSchema(behaviours ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      v2.AlertV2NoDataBehaviourDoNothing,
+		ValidateFunc: validation.StringInSlice(behaviours, false),
+	}
+}
+
+// createAlertV2NotificationChannelsSchema returns the notification channels of an alert, with the options overridden for the alert.
+func createAlertV2NotificationChannelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+				"renotify_every_minutes": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  0,
+				},
+				"notify_on_resolve": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"notify_on_acknowledge": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+				},
+				"main_threshold": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"warning_threshold": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+// buildAlertV2NotificationChannelConfig returns the configuration of a notification channel of the notification_channels argument.
+func buildAlertV2NotificationChannelConfig(channelMap map[string]any) v2.NotificationChannelConfigV2 {
+	newChannel := v2.NotificationChannelConfigV2{
+		ChannelID: channelMap["id"].(int),
+		// Type: will be added by the sysdig client before the put/post
+	}
+
+	if renotifyEveryMinutes, ok := channelMap["renotify_every_minutes"]; ok {
+		m := renotifyEveryMinutes.(int)
+		if m != 0 {
+			s := minutesToSeconds(m)
+			newChannel.OverrideOptions.ReNotifyEverySec = &s
+		}
+	}
+
+	newChannel.OverrideOptions.NotifyOnResolve = channelMap["notify_on_resolve"].(bool)
+	if notifyOnAcknowledge, ok := channelMap["notify_on_acknowledge"]; ok && notifyOnAcknowledge.(string) != "" {
+		if notifyOnAcknowledge.(string) == "true" {
+			trueValue := true
+			newChannel.OverrideOptions.NotifyOnAcknowledge = &trueValue
+		} else {
+			falseValue := false
+			newChannel.OverrideOptions.NotifyOnAcknowledge = &falseValue
+		}
+		// else do not set any value for newChannel.OverrideOptions.NotifyOnAcknowledge
+	}
+
+	newChannel.OverrideOptions.Thresholds = []string{}
+	mainThreshold := channelMap["main_threshold"].(bool)
+	if mainThreshold {
+		newChannel.OverrideOptions.Thresholds = append(newChannel.OverrideOptions.Thresholds, "MAIN")
+	}
+	warningThreshold := channelMap["warning_threshold"].(bool)
+	if warningThreshold {
+		newChannel.OverrideOptions.Thresholds = append(newChannel.OverrideOptions.Thresholds, "WARNING")
+	}
+
+	return newChannel
+}
+
+// alertV2NotificationChannelConfigToMap returns the notification_channels argument of the configuration of a notification channel.
+func alertV2NotificationChannelConfigToMap(ncc v2.NotificationChannelConfigV2) map[string]any {
+	config := map[string]any{
+		"id":                ncc.ChannelID,
+		"notify_on_resolve": ncc.OverrideOptions.NotifyOnResolve,
+	}
+
+	if ncc.OverrideOptions.NotifyOnAcknowledge != nil {
+		if *ncc.OverrideOptions.NotifyOnAcknowledge {
+			config["notify_on_acknowledge"] = "true"
+		} else {
+			config["notify_on_acknowledge"] = "false"
+		}
+	}
+
+	if ncc.OverrideOptions.ReNotifyEverySec != nil {
+		config["renotify_every_minutes"] = secondsToMinutes(*ncc.OverrideOptions.ReNotifyEverySec)
+	} else {
+		config["renotify_every_minutes"] = 0
+	}
+
+	if ncc.OverrideOptions.Thresholds != nil {
+		config["main_threshold"] = false
+		config["warning_threshold"] = false
+		for _, t := range ncc.OverrideOptions.Thresholds {
+			if t == "MAIN" {
+				config["main_threshold"] = true
+			}
+			if t == "WARNING" {
+				config["warning_threshold"] = true
+			}
+		}
+	} else {
+		// defaults
+		config["main_threshold"] = true
+		config["warning_threshold"] = false
+	}
+
+	return config
+}
+
 func AlertV2SeverityValues() []string {
 	return []string{
 		string(v2.AlertV2SeverityHigh),
@@ -253,43 +352,7 @@ func buildAlertV2CommonStruct(d *schema.ResourceData) *v2.AlertV2Common {
 		channels := []v2.NotificationChannelConfigV2{}
 
 		for _, channel := range attr.(*schema.Set).List() {
-			channelMap := channel.(map[string]any)
-			newChannel := v2.NotificationChannelConfigV2{
-				ChannelID: channelMap["id"].(int),
-				// Type: will be added by the sysdig client before the put/post
-			}
-
-			if renotifyEveryMinutes, ok := channelMap["renotify_every_minutes"]; ok {
-				m := renotifyEveryMinutes.(int)
-				if m != 0 {
-					s := minutesToSeconds(m)
-					newChannel.OverrideOptions.ReNotifyEverySec = &s
-				}
-			}
-
-			newChannel.OverrideOptions.NotifyOnResolve = channelMap["notify_on_resolve"].(bool)
-			if notifyOnAcknowledge, ok := channelMap["notify_on_acknowledge"]; ok && notifyOnAcknowledge.(string) != "" {
-				if notifyOnAcknowledge.(string) == "true" {
-					trueValue := true
-					newChannel.OverrideOptions.NotifyOnAcknowledge = &trueValue
-				} else {
-					falseValue := false
-					newChannel.OverrideOptions.NotifyOnAcknowledge = &falseValue
-				}
-				// else do not set any value for newChannel.OverrideOptions.NotifyOnAcknowledge
-			}
-
-			newChannel.OverrideOptions.Thresholds = []string{}
-			mainThreshold := channelMap["main_threshold"].(bool)
-			if mainThreshold {
-				newChannel.OverrideOptions.Thresholds = append(newChannel.OverrideOptions.Thresholds, "MAIN")
-			}
-			warningThreshold := channelMap["warning_threshold"].(bool)
-			if warningThreshold {
-				newChannel.OverrideOptions.Thresholds = append(newChannel.OverrideOptions.Thresholds, "WARNING")
-			}
-
-			channels = append(channels, newChannel)
+			channels = append(channels, buildAlertV2NotificationChannelConfig(channel.(map[string]any)))
 		}
 		alert.NotificationChannelConfigList = channels
 	}
@@ -369,43 +432,7 @@ func updateAlertV2CommonState(d *schema.ResourceData, alert *v2.AlertV2Common) (
 
 	var notificationChannels []any
 	for _, ncc := range alert.NotificationChannelConfigList {
-		config := map[string]any{
-			"id":                ncc.ChannelID,
-			"notify_on_resolve": ncc.OverrideOptions.NotifyOnResolve,
-		}
-
-		if ncc.OverrideOptions.NotifyOnAcknowledge != nil {
-			if *ncc.OverrideOptions.NotifyOnAcknowledge {
-				config["notify_on_acknowledge"] = "true"
-			} else {
-				config["notify_on_acknowledge"] = "false"
-			}
-		}
-
-		if ncc.OverrideOptions.ReNotifyEverySec != nil {
-			config["renotify_every_minutes"] = secondsToMinutes(*ncc.OverrideOptions.ReNotifyEverySec)
-		} else {
-			config["renotify_every_minutes"] = 0
-		}
-
-		if ncc.OverrideOptions.Thresholds != nil {
-			config["main_threshold"] = false
-			config["warning_threshold"] = false
-			for _, t := range ncc.OverrideOptions.Thresholds {
-				if t == "MAIN" {
-					config["main_threshold"] = true
-				}
-				if t == "WARNING" {
-					config["warning_threshold"] = true
-				}
-			}
-		} else {
-			// defaults
-			config["main_threshold"] = true
-			config["warning_threshold"] = false
-		}
-
-		notificationChannels = append(notificationChannels, config)
+		notificationChannels = append(notificationChannels, alertV2NotificationChannelConfigToMap(ncc))
 	}
 	_ = d.Set("notification_channels", notificationChannels)
 
@@ -15,7 +15,7 @@ import (
 func resourceSysdigMonitorAlertV2Downtime() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorAlertV2DowntimeCreate,
 		UpdateContext: resourceSysdigMonitorAlertV2DowntimeUpdate,
 		ReadContext:   resourceSysdigMonitorAlertV2DowntimeRead,
@@ -54,7 +54,7 @@ func resourceSysdigMonitorAlertV2Downtime() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(60),
 			},
 		})),
-	}
+	})
 }
 
 func getAlertV2DowntimeClient(c SysdigClients) (v2.AlertV2DowntimeInterface, error) {
@@ -97,7 +97,7 @@ func resourceSysdigMonitorAlertV2DowntimeRead(ctx context.Context, d *schema.Res
 
 	a, err := client.GetAlertV2DowntimeByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -16,7 +16,7 @@ import (
 func resourceSysdigMonitorAlertV2Event() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorAlertV2EventCreate,
 		UpdateContext: resourceSysdigMonitorAlertV2EventUpdate,
 		ReadContext:   resourceSysdigMonitorAlertV2EventRead,
@@ -63,7 +63,7 @@ func resourceSysdigMonitorAlertV2Event() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		})),
-	}
+	})
 }
 
 func getAlertV2EventClient(c SysdigClients) (v2.AlertV2EventInterface, error) {
@@ -109,7 +109,7 @@ func resourceSysdigMonitorAlertV2EventRead(ctx context.Context, d *schema.Resour
 
 	a, err := client.GetAlertV2EventByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
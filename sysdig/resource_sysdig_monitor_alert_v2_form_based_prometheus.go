@@ -16,7 +16,7 @@ import (
 func resourceSysdigMonitorAlertV2FormBasedPrometheus() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorAlertV2FormBasedPrometheusCreate,
 		UpdateContext: resourceSysdigMonitorAlertV2FormBasedPrometheusUpdate,
 		ReadContext:   resourceSysdigMonitorAlertV2FormBasedPrometheusRead,
@@ -32,6 +32,8 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheus() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validatePromQLLabels},
+
 		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
 			"duration_seconds": {
 				Type:         schema.TypeInt,
@@ -53,22 +55,18 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheus() *schema.Resource {
 				Default:  "",
 			},
 			"query": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"no_data_behaviour": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DO_NOTHING",
-				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validatePromQL,
 			},
+			"no_data_behaviour": createAlertV2NoDataBehaviourSchema(v2.AlertV2NoDataBehaviourDoNothing, v2.AlertV2NoDataBehaviourTrigger),
 			"unreported_alert_notifications_retention_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(60),
 			},
 		})),
-	}
+	})
 }
 
 func getAlertV2FormBasedPrometheusClient(c SysdigClients) (v2.AlertV2FormBasedPrometheusInterface, error) {
@@ -114,7 +112,7 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheusRead(ctx context.Context, d
 
 	a, err := client.GetAlertV2FormBasedPrometheusByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -72,12 +72,7 @@ func resourceSysdigMonitorAlertV2GroupOutlier() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice([]string{"avg", "sum", "min", "max"}, false),
 			},
-			"no_data_behaviour": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DO_NOTHING",
-				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
-			},
+			"no_data_behaviour": createAlertV2NoDataBehaviourSchema(v2.AlertV2NoDataBehaviourDoNothing, v2.AlertV2NoDataBehaviourTrigger),
 			"unreported_alert_notifications_retention_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -104,7 +99,7 @@ func resourceSysdigMonitorAlertV2GroupOutlier() *schema.Resource {
 	resource.Schema["group_by"].Optional = false
 	resource.Schema["group_by"].Required = true
 
-	return resource
+	return teamScopedResource(resource)
 }
 
 func getAlertV2GroupOutlierClient(c SysdigClients) (v2.AlertV2GroupOutlierInterface, error) {
@@ -150,7 +145,7 @@ func resourceSysdigMonitorAlertV2GroupOutlierRead(ctx context.Context, d *schema
 
 	a, err := client.GetAlertV2GroupOutlierByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -16,7 +16,7 @@ import (
 func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorAlertV2MetricCreate,
 		UpdateContext: resourceSysdigMonitorAlertV2MetricUpdate,
 		ReadContext:   resourceSysdigMonitorAlertV2MetricRead,
@@ -73,19 +73,14 @@ func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice([]string{"avg", "sum", "min", "max"}, false),
 			},
-			"no_data_behaviour": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DO_NOTHING",
-				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
-			},
+			"no_data_behaviour": createAlertV2NoDataBehaviourSchema(v2.AlertV2NoDataBehaviourDoNothing, v2.AlertV2NoDataBehaviourTrigger),
 			"unreported_alert_notifications_retention_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(60),
 			},
 		})),
-	}
+	})
 }
 
 func getAlertV2MetricClient(c SysdigClients) (v2.AlertV2MetricInterface, error) {
@@ -131,7 +126,7 @@ func resourceSysdigMonitorAlertV2MetricRead(ctx context.Context, d *schema.Resou
 
 	a, err := client.GetAlertV2MetricByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
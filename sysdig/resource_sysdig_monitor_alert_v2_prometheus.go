@@ -15,7 +15,7 @@ import (
 func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorAlertV2PrometheusCreate,
 		UpdateContext: resourceSysdigMonitorAlertV2PrometheusUpdate,
 		ReadContext:   resourceSysdigMonitorAlertV2PrometheusRead,
@@ -31,6 +31,8 @@ func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validatePromQLLabels},
+
 		Schema: createAlertV2Schema(map[string]*schema.Schema{
 			"duration_seconds": {
 				Type:         schema.TypeInt,
@@ -39,8 +41,9 @@ func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"query": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validatePromQL,
 			},
 			"keep_firing_for_minutes": {
 				Type:         schema.TypeInt,
@@ -48,7 +51,7 @@ func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 		}),
-	}
+	})
 }
 
 func getAlertV2PrometheusClient(c SysdigClients) (v2.AlertV2PrometheusInterface, error) {
@@ -91,7 +94,7 @@ func resourceSysdigMonitorAlertV2PrometheusRead(ctx context.Context, d *schema.R
 
 	a, err := client.GetAlertV2PrometheusByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -4,25 +4,19 @@ package sysdig_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 func TestAccAlertV2Prometheus(t *testing.T) {
 	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: alertV2PrometheusWithName(rText()),
@@ -36,6 +30,10 @@ func TestAccAlertV2Prometheus(t *testing.T) {
 			{
 				Config: alertV2PrometheusWithKeepFiringFor(rText()),
 			},
+			{
+				Config:      alertV2PrometheusWithInvalidQuery(rText()),
+				ExpectError: regexp.MustCompile(`invalid PromQL query`),
+			},
 			{
 				ResourceName:      "sysdig_monitor_alert_v2_prometheus.sample",
 				ImportState:       true,
@@ -98,3 +96,12 @@ resource "sysdig_monitor_alert_v2_prometheus" "sample" {
 }
 `, name, name)
 }
+
+func alertV2PrometheusWithInvalidQuery(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_prometheus" "sample" {
+	name = "TERRAFORM TEST - PROMQL %s"
+	query = "sum(rate(elasticsearch_jvm_memory_used_bytes[5m]) > 80"
+}
+`, name)
+}
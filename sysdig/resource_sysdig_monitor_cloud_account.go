@@ -136,6 +136,10 @@ func resourceSysdigMonitorCloudAccountRead(ctx context.Context, data *schema.Res
 	if data.Get("integration_type").(string) == "Cost" {
 		cloudAccount, err := client.GetCloudAccountMonitorForCostByID(ctx, id)
 		if err != nil {
+			if v2.IsNotFound(err) {
+				data.SetId("")
+				return nil
+			}
 			return diag.FromErr(err)
 		}
 
@@ -146,6 +150,10 @@ func resourceSysdigMonitorCloudAccountRead(ctx context.Context, data *schema.Res
 	} else {
 		cloudAccount, err := client.GetCloudAccountMonitorByID(ctx, id)
 		if err != nil {
+			if v2.IsNotFound(err) {
+				data.SetId("")
+				return nil
+			}
 			return diag.FromErr(err)
 		}
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
@@ -19,7 +21,7 @@ import (
 func resourceSysdigMonitorDashboard() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigDashboardCreate,
 		UpdateContext: resourceSysdigDashboardUpdate,
 		ReadContext:   resourceSysdigDashboardRead,
@@ -71,7 +73,19 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 									},
 									"id": {
 										Type:     schema.TypeInt,
-										Required: true,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"email": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"resolved_id": {
+										Type:     schema.TypeInt,
+										Computed: true,
 									},
 								},
 							},
@@ -108,6 +122,51 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"values_query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"event_display": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"severities": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"high", "medium", "low", "info"}, false)),
+							},
+						},
+						"alert_statuses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"categories": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"team_scope": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -148,7 +207,7 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 						"type": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"timechart", "number", "text"}, false)),
+							ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(slices.Sorted(maps.Keys(dashboardPanelTypes)), false)),
 						},
 						"content": {
 							Type:     schema.TypeString,
@@ -176,8 +235,9 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"promql": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validatePromQL,
 									},
 									"unit": {
 										Type:             schema.TypeString,
@@ -242,6 +302,106 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 								},
 							},
 						},
+						"table": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"page_size": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          v2.NewTableSettings().PageSize,
+										ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+									},
+									"sort_by": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sort_order": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          v2.NewTableSettings().SortOrder,
+										ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+									},
+								},
+							},
+						},
+						"toplist": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"limit": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          v2.NewToplistSettings().Limit,
+										ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+									},
+									"sort_order": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          v2.NewToplistSettings().SortOrder,
+										ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+									},
+								},
+							},
+						},
+						"histogram": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_count": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          v2.NewHistogramSettings().BucketCount,
+										ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+									},
+								},
+							},
+						},
+						"gauge": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_value": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										Default:  v2.NewGaugeSettings().MinValue,
+									},
+									"max_value": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										Default:  v2.NewGaugeSettings().MaxValue,
+									},
+								},
+							},
+						},
+						"bar_chart": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"orientation": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          v2.NewBarChartSettings().Orientation,
+										ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"horizontal", "vertical"}, false)),
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  v2.NewBarChartSettings().Stacked,
+									},
+								},
+							},
+						},
 						"legend": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -279,10 +439,29 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"folder_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 		},
-	}
+	})
 }
 
+// dashboardPanelTypes maps the panel types of the resource to the API ones.
+var dashboardPanelTypes = map[string]v2.PanelType{
+	"timechart": v2.PanelTypeTimechart,
+	"number":    v2.PanelTypeNumber,
+	"text":      v2.PanelTypeText,
+	"table":     v2.PanelTypeTable,
+	"toplist":   v2.PanelTypeToplist,
+	"histogram": v2.PanelTypeHistogram,
+	"gauge":     v2.PanelTypeGauge,
+	"bar_chart": v2.PanelTypeBarChart,
+}
+
+// dashboardPanelSettings are the panel types that have a block with their display settings, named after the type.
+var dashboardPanelSettings = []string{"table", "toplist", "histogram", "gauge", "bar_chart"}
+
 func getMonitorDashboardClient(c SysdigClients) (v2.DashboardInterface, error) {
 	var client v2.DashboardInterface
 	var err error
@@ -301,13 +480,31 @@ func getMonitorDashboardClient(c SysdigClients) (v2.DashboardInterface, error) {
 	return client, nil
 }
 
+func getMonitorCommonClient(c SysdigClients) (v2.Common, error) {
+	var client v2.Common
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
 func resourceSysdigDashboardCreate(ctx context.Context, data *schema.ResourceData, i any) diag.Diagnostics {
 	client, err := getMonitorDashboardClient(i.(SysdigClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	dashboard, err := dashboardFromResourceData(data)
+	dashboard, err := dashboardFromResourceData(ctx, data, i.(SysdigClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -319,6 +516,7 @@ func resourceSysdigDashboardCreate(ctx context.Context, data *schema.ResourceDat
 
 	data.SetId(strconv.Itoa(dashboardCreated.ID))
 	_ = data.Set("version", dashboardCreated.Version)
+	setShareMembersResolvedID(data, dashboard.SharingSettings)
 
 	return nil
 }
@@ -329,7 +527,7 @@ func resourceSysdigDashboardUpdate(ctx context.Context, data *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	dashboard, err := dashboardFromResourceData(data)
+	dashboard, err := dashboardFromResourceData(ctx, data, i.(SysdigClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -340,6 +538,7 @@ func resourceSysdigDashboardUpdate(ctx context.Context, data *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	setShareMembersResolvedID(data, dashboard.SharingSettings)
 
 	return nil
 }
@@ -357,11 +556,14 @@ func resourceSysdigDashboardRead(ctx context.Context, data *schema.ResourceData,
 
 	dashboard, err := client.GetDashboardByID(ctx, id)
 	if err != nil {
-		data.SetId("")
-		return nil
+		if v2.IsNotFound(err) {
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	err = dashboardToResourceData(dashboard, data)
+	err = dashboardToResourceData(ctx, dashboard, data, i.(SysdigClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -388,11 +590,12 @@ func resourceSysdigDashboardDelete(ctx context.Context, data *schema.ResourceDat
 	return nil
 }
 
-func dashboardFromResourceData(data *schema.ResourceData) (dashboard *v2.Dashboard, err error) {
+func dashboardFromResourceData(ctx context.Context, data *schema.ResourceData, clients SysdigClients) (dashboard *v2.Dashboard, err error) {
 	dashboard = v2.NewDashboard(data.Get("name").(string), data.Get("description").(string)).AsPublic(data.Get("public").(bool))
 	dashboard.Version = cast.ToInt(data.Get("version"))
 	dashboard.PublicToken = data.Get("public_token").(string)
 	dashboard.MinInterval = data.Get("min_interval").(string)
+	dashboard.FolderID = data.Get("folder_id").(int)
 
 	panels, err := panelsFromResourceData(data)
 	if err != nil {
@@ -404,10 +607,11 @@ func dashboardFromResourceData(data *schema.ResourceData) (dashboard *v2.Dashboa
 		return nil, err
 	}
 	dashboard.ScopeExpressionList = scopes
+	dashboard.EventDisplaySettings = eventDisplaySettingsFromResourceData(data)
 
 	dashboard.AddPanels(panels...)
 
-	shares, err := sharingFromResourceData(data)
+	shares, err := sharingFromResourceData(ctx, data, &shareMemberResolver{clients: clients})
 	if err != nil {
 		return nil, err
 	}
@@ -415,36 +619,127 @@ func dashboardFromResourceData(data *schema.ResourceData) (dashboard *v2.Dashboa
 	return dashboard, nil
 }
 
-func sharingFromResourceData(data *schema.ResourceData) (sharingSettings []*v2.SharingOptions, err error) {
+func sharingFromResourceData(ctx context.Context, data *schema.ResourceData, resolver *shareMemberResolver) (sharingSettings []*v2.SharingOptions, err error) {
 	for _, share := range data.Get("share").(*schema.Set).List() {
 		shareInfo := share.(map[string]any)
 		memberInfo := shareInfo["member"].(*schema.Set).List()[0].(map[string]any)
+		member, err := resolver.member(ctx, memberInfo)
+		if err != nil {
+			return nil, err
+		}
 		sharingSettings = append(sharingSettings,
 			&v2.SharingOptions{
-				Member: v2.SharingMember{
-					Type: memberInfo["type"].(string),
-					ID:   memberInfo["id"].(int),
-				},
-				Role: shareInfo["role"].(string),
+				Member: member,
+				Role:   shareInfo["role"].(string),
 			})
 	}
 	return sharingSettings, err
 }
 
+// setShareMembersResolvedID stores the IDs the share members were resolved to, in the order of
+// the share blocks the sharing settings were built from, so that the refreshes don't need to
+// resolve their names and emails again.
+func setShareMembersResolvedID(data *schema.ResourceData, sharingSettings []*v2.SharingOptions) {
+	var shares []map[string]any
+	for i, shareItr := range data.Get("share").(*schema.Set).List() {
+		shareInfo := shareItr.(map[string]any)
+		memberInfo := shareInfo["member"].(*schema.Set).List()[0].(map[string]any)
+		memberInfo["resolved_id"] = sharingSettings[i].Member.ID
+		shares = append(shares, map[string]any{
+			"role":   shareInfo["role"],
+			"member": []map[string]any{memberInfo},
+		})
+	}
+	_ = data.Set("share", shares)
+}
+
+// shareMemberResolver resolves the team names and user emails the share members can be
+// referenced by to their IDs.
+type shareMemberResolver struct {
+	clients SysdigClients
+	teams   []v2.Team
+}
+
+func (r *shareMemberResolver) member(ctx context.Context, memberInfo map[string]any) (v2.SharingMember, error) {
+	member := v2.SharingMember{
+		Type: memberInfo["type"].(string),
+		ID:   memberInfo["id"].(int),
+	}
+	name := memberInfo["name"].(string)
+	email := memberInfo["email"].(string)
+
+	references := 0
+	for _, set := range []bool{member.ID != 0, name != "", email != ""} {
+		if set {
+			references++
+		}
+	}
+	if references != 1 {
+		return member, errors.New(`exactly one of "id", "name" or "email" must be set in a share member`)
+	}
+
+	switch {
+	case name != "":
+		if member.Type != "TEAM" {
+			return member, errors.New(`"name" can only be set in share members of type TEAM`)
+		}
+		client, err := getMonitorCommonClient(r.clients)
+		if err != nil {
+			return member, err
+		}
+		if r.teams == nil {
+			r.teams, err = client.ListTeams(ctx)
+			if err != nil {
+				return member, err
+			}
+		}
+		for _, team := range r.teams {
+			if team.Name == name {
+				member.ID = team.ID
+				return member, nil
+			}
+		}
+		return member, fmt.Errorf("team %q not found", name)
+	case email != "":
+		if member.Type != "USER" {
+			return member, errors.New(`"email" can only be set in share members of type USER`)
+		}
+		client, err := getMonitorCommonClient(r.clients)
+		if err != nil {
+			return member, err
+		}
+		user, err := client.GetUserByEmail(ctx, email)
+		if err != nil {
+			return member, fmt.Errorf("unable to find user %q: %w", email, err)
+		}
+		member.ID = user.ID
+	}
+	return member, nil
+}
+
 func panelsFromResourceData(data *schema.ResourceData) (panels []*v2.Panels, err error) {
 	for _, panelItr := range data.Get("panel").(*schema.Set).List() {
 		panelInfo := panelItr.(map[string]any)
 
+		panelType := panelInfo["type"].(string)
+		for _, settings := range dashboardPanelSettings {
+			if settings != panelType && panelSettingsFromResourceData(panelInfo, settings) != nil {
+				return nil, fmt.Errorf("the %s block can only be set in panels of type %s, not in %s panel %q", settings, settings, panelType, panelInfo["name"])
+			}
+		}
+
 		var panel *v2.Panels
-		switch panelInfo["type"].(string) {
-		case "timechart":
+		switch dashboardPanelTypes[panelType] {
+		case v2.PanelTypeTimechart:
 			panel, err = timechartPanelFromResourceData(panelInfo)
-		case "number":
+		case v2.PanelTypeNumber:
 			panel, err = numberPanelFromResourceData(panelInfo)
-		case "text":
+		case v2.PanelTypeText:
 			panel, err = textPanelFromResourceData(panelInfo)
+		case v2.PanelTypeTable, v2.PanelTypeToplist, v2.PanelTypeHistogram, v2.PanelTypeGauge, v2.PanelTypeBarChart:
+			panel, err = settingsPanelFromResourceData(panelInfo)
 		default:
-			return nil, fmt.Errorf("unsupported panel type %s", panelInfo["type"])
+			return nil, fmt.Errorf("unsupported panel type %s", panelType)
 		}
 		if err != nil {
 			return nil, err
@@ -643,6 +938,88 @@ func textPanelFromResourceData(panelInfo map[string]any) (*v2.Panels, error) {
 	return panel, nil
 }
 
+// settingsPanelFromResourceData builds the query panels whose display is configured with a settings block.
+func settingsPanelFromResourceData(panelInfo map[string]any) (*v2.Panels, error) {
+	panelType := panelInfo["type"].(string)
+	panel := &v2.Panels{
+		ID:          0,
+		Name:        panelInfo["name"].(string),
+		Description: panelInfo["description"].(string),
+		Type:        dashboardPanelTypes[panelType],
+	}
+
+	settings := panelSettingsFromResourceData(panelInfo, panelType)
+	switch panel.Type {
+	case v2.PanelTypeTable:
+		panel.TableSettings = v2.NewTableSettings()
+		if settings != nil {
+			panel.TableSettings.PageSize = settings["page_size"].(int)
+			panel.TableSettings.SortBy = settings["sort_by"].(string)
+			panel.TableSettings.SortOrder = settings["sort_order"].(string)
+		}
+	case v2.PanelTypeToplist:
+		panel.ToplistSettings = v2.NewToplistSettings()
+		if settings != nil {
+			panel.ToplistSettings.Limit = settings["limit"].(int)
+			panel.ToplistSettings.SortOrder = settings["sort_order"].(string)
+		}
+	case v2.PanelTypeHistogram:
+		panel.HistogramSettings = v2.NewHistogramSettings()
+		if settings != nil {
+			panel.HistogramSettings.BucketCount = settings["bucket_count"].(int)
+		}
+	case v2.PanelTypeGauge:
+		panel.GaugeSettings = v2.NewGaugeSettings()
+		if settings != nil {
+			panel.GaugeSettings.MinValue = settings["min_value"].(float64)
+			panel.GaugeSettings.MaxValue = settings["max_value"].(float64)
+		}
+		if panel.GaugeSettings.MinValue >= panel.GaugeSettings.MaxValue {
+			return nil, fmt.Errorf("the min_value of gauge panel %q must be lower than its max_value", panel.Name)
+		}
+	case v2.PanelTypeBarChart:
+		panel.BarChartSettings = v2.NewBarChartSettings()
+		if settings != nil {
+			panel.BarChartSettings.Orientation = settings["orientation"].(string)
+			panel.BarChartSettings.Stacked = settings["stacked"].(bool)
+		}
+	}
+
+	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := queriesFromResourceData(panelInfo, panel)
+	if err != nil {
+		return nil, err
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no query defined for %s panel", panelType)
+	}
+
+	_, err = panel.AddQueries(queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	return panel, nil
+}
+
+// panelSettingsFromResourceData returns the fields of the settings block of the panel, or nil if it is not set.
+func panelSettingsFromResourceData(panelInfo map[string]any, key string) map[string]any {
+	settingsData, ok := panelInfo[key]
+	if !ok || settingsData == nil {
+		return nil
+	}
+
+	settingsList := settingsData.(*schema.Set).List()
+	if len(settingsList) == 0 {
+		return nil
+	}
+	return settingsList[0].(map[string]any)
+}
+
 func formatFromResourceData(queryInfo map[string]any) *v2.Format {
 	formatData, ok := queryInfo["format"]
 	if !ok {
@@ -729,12 +1106,13 @@ func queriesFromResourceData(panelInfo map[string]any, panel *v2.Panels) (newQue
 	return newQueries, err
 }
 
-func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData) (err error) {
+func dashboardToResourceData(ctx context.Context, dashboard *v2.Dashboard, data *schema.ResourceData, clients SysdigClients) (err error) {
 	_ = data.Set("name", dashboard.Name)
 	_ = data.Set("description", dashboard.Description)
 	_ = data.Set("public", dashboard.Public)
 	_ = data.Set("public_token", dashboard.PublicToken)
 	_ = data.Set("min_interval", dashboard.MinInterval)
+	_ = data.Set("folder_id", dashboard.FolderID)
 
 	var panels []map[string]any
 	for i, panel := range dashboard.Panels {
@@ -761,11 +1139,28 @@ func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData)
 		scopes = append(scopes, dScope)
 	}
 	_ = data.Set("scope", scopes)
+	_ = data.Set("event_display", eventDisplaySettingsToResourceData(dashboard.EventDisplaySettings, data))
 	_ = data.Set("version", dashboard.Version)
 
+	// the members referenced by name or email in the configuration are kept that way, matched by
+	// the ID they were resolved to when they were applied
+	resolver := &shareMemberResolver{clients: clients}
+	configuredMembers := map[v2.SharingMember]map[string]any{}
+	for _, share := range data.Get("share").(*schema.Set).List() {
+		memberInfo := share.(map[string]any)["member"].(*schema.Set).List()[0].(map[string]any)
+		member := v2.SharingMember{Type: memberInfo["type"].(string), ID: memberInfo["resolved_id"].(int)}
+		if member.ID == 0 {
+			// the states written before the resolved IDs were stored
+			if member, err = resolver.member(ctx, memberInfo); err != nil {
+				return err
+			}
+		}
+		configuredMembers[member] = memberInfo
+	}
+
 	var shares []map[string]any
 	for _, share := range dashboard.SharingSettings {
-		dShare, err := shareToResourceData(share)
+		dShare, err := shareToResourceData(share, configuredMembers)
 		if err != nil {
 			return err
 		}
@@ -776,13 +1171,19 @@ func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData)
 	return nil
 }
 
-func shareToResourceData(share *v2.SharingOptions) (map[string]any, error) {
+func shareToResourceData(share *v2.SharingOptions, configuredMembers map[v2.SharingMember]map[string]any) (map[string]any, error) {
+	member := map[string]any{
+		"type": share.Member.Type,
+		"id":   share.Member.ID,
+	}
+	if memberInfo, ok := configuredMembers[share.Member]; ok {
+		member = memberInfo
+	}
+	member["resolved_id"] = share.Member.ID
+
 	res := map[string]any{
-		"role": share.Role,
-		"member": []map[string]any{{
-			"type": share.Member.Type,
-			"id":   share.Member.ID,
-		}},
+		"role":   share.Role,
+		"member": []map[string]any{member},
 	}
 	return res, nil
 }
@@ -799,6 +1200,7 @@ func scopeToResourceData(scope *v2.ScopeExpressionList) (map[string]any, error)
 
 	if scope.IsVariable && scope.DisplayName != "" {
 		res["variable"] = scope.DisplayName
+		res["values_query"] = scope.ValuesQuery
 	}
 
 	return res, nil
@@ -825,14 +1227,18 @@ func scopeFromResourceData(data *schema.ResourceData) ([]*v2.ScopeExpressionList
 			scope.Value = value
 		}
 		variable := cast.ToString(scopeInfo["variable"])
+		valuesQuery := cast.ToString(scopeInfo["values_query"])
 		if variable != "" {
 			scope.DisplayName = variable
 			scope.IsVariable = true
+			scope.ValuesQuery = valuesQuery
 			if scope.Operator == "" {
 				scope.Operator = "in"
 			}
 		} else if comparator == "" || len(value) == 0 {
 			return nil, errors.New(`"comparator" and "value" must be set if "variable" is not set`)
+		} else if valuesQuery != "" {
+			return nil, errors.New(`"values_query" can only be set if "variable" is set`)
 		}
 
 		scopes = append(scopes, scope)
@@ -840,6 +1246,49 @@ func scopeFromResourceData(data *schema.ResourceData) ([]*v2.ScopeExpressionList
 	return scopes, nil
 }
 
+func eventDisplaySettingsFromResourceData(data *schema.ResourceData) v2.EventDisplaySettings {
+	settings := v2.EventDisplaySettings{
+		QueryParams: v2.QueryParams{
+			Severities:    []any{},
+			AlertStatuses: []any{},
+			Categories:    []any{},
+		},
+	}
+
+	eventDisplayList := data.Get("event_display").([]any)
+	if len(eventDisplayList) == 0 || eventDisplayList[0] == nil {
+		return settings
+	}
+
+	eventDisplay := eventDisplayList[0].(map[string]any)
+	settings.Enabled = eventDisplay["enabled"].(bool)
+	settings.QueryParams.Filter = eventDisplay["filter"].(string)
+	settings.QueryParams.Severities = eventDisplay["severities"].(*schema.Set).List()
+	settings.QueryParams.AlertStatuses = eventDisplay["alert_statuses"].(*schema.Set).List()
+	settings.QueryParams.Categories = eventDisplay["categories"].(*schema.Set).List()
+	settings.QueryParams.TeamScope = eventDisplay["team_scope"].(bool)
+	return settings
+}
+
+func eventDisplaySettingsToResourceData(settings v2.EventDisplaySettings, data *schema.ResourceData) []map[string]any {
+	queryParams := settings.QueryParams
+	// If the event display is not defined in the user configuration and the dashboard doesn't display events
+	// we don't set the event display in the resource data to avoid drifts
+	if len(data.Get("event_display").([]any)) == 0 && !settings.Enabled && queryParams.Filter == "" &&
+		len(queryParams.Severities) == 0 && len(queryParams.AlertStatuses) == 0 && len(queryParams.Categories) == 0 && !queryParams.TeamScope {
+		return nil
+	}
+
+	return []map[string]any{{
+		"enabled":        settings.Enabled,
+		"filter":         queryParams.Filter,
+		"severities":     cast.ToStringSlice(queryParams.Severities),
+		"alert_statuses": cast.ToStringSlice(queryParams.AlertStatuses),
+		"categories":     cast.ToStringSlice(queryParams.Categories),
+		"team_scope":     queryParams.TeamScope,
+	}}
+}
+
 func panelToResourceData(panel *v2.Panels, layout []*v2.Layout, panelData map[string]any) (map[string]any, error) {
 	var panelLayout *v2.Layout
 
@@ -859,6 +1308,8 @@ func panelToResourceData(panel *v2.Panels, layout []*v2.Layout, panelData map[st
 		return numberPanelToResourceData(panel, panelLayout, panelData)
 	case v2.PanelTypeText:
 		return textPanelToResourceData(panel, panelLayout)
+	case v2.PanelTypeTable, v2.PanelTypeToplist, v2.PanelTypeHistogram, v2.PanelTypeGauge, v2.PanelTypeBarChart:
+		return settingsPanelToResourceData(panel, panelLayout, panelData)
 	default:
 		return nil, fmt.Errorf("unsupported panel type %s", panel.Type)
 	}
@@ -919,6 +1370,107 @@ func numberPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelDa
 	}, nil
 }
 
+func settingsPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]any) (map[string]any, error) {
+	queries, err := queriesToResourceData(panel.AdvancedQueries, panelData)
+	if err != nil {
+		return nil, err
+	}
+
+	var panelType string
+	for name, t := range dashboardPanelTypes {
+		if t == panel.Type {
+			panelType = name
+		}
+	}
+
+	var settings, defaultSettings map[string]any
+	switch panel.Type {
+	case v2.PanelTypeTable:
+		settings = tableSettingsToResourceData(panel.TableSettings)
+		defaultSettings = tableSettingsToResourceData(v2.NewTableSettings())
+	case v2.PanelTypeToplist:
+		settings = toplistSettingsToResourceData(panel.ToplistSettings)
+		defaultSettings = toplistSettingsToResourceData(v2.NewToplistSettings())
+	case v2.PanelTypeHistogram:
+		settings = histogramSettingsToResourceData(panel.HistogramSettings)
+		defaultSettings = histogramSettingsToResourceData(v2.NewHistogramSettings())
+	case v2.PanelTypeGauge:
+		settings = gaugeSettingsToResourceData(panel.GaugeSettings)
+		defaultSettings = gaugeSettingsToResourceData(v2.NewGaugeSettings())
+	case v2.PanelTypeBarChart:
+		settings = barChartSettingsToResourceData(panel.BarChartSettings)
+		defaultSettings = barChartSettingsToResourceData(v2.NewBarChartSettings())
+	}
+
+	res := map[string]any{
+		"pos_x":       panelLayout.X,
+		"pos_y":       panelLayout.Y,
+		"width":       panelLayout.W,
+		"height":      panelLayout.H,
+		"name":        panel.Name,
+		"description": panel.Description,
+		"type":        panelType,
+		"query":       queries,
+	}
+
+	// If the settings are not defined in the user configuration and the panel ones are the default ones
+	// we don't set the settings in the resource data to avoid drifts
+	if panelSettingsFromResourceData(panelData, panelType) != nil || !reflect.DeepEqual(settings, defaultSettings) {
+		res[panelType] = []map[string]any{settings}
+	}
+	return res, nil
+}
+
+func tableSettingsToResourceData(settings *v2.TableSettings) map[string]any {
+	if settings == nil {
+		settings = v2.NewTableSettings()
+	}
+	return map[string]any{
+		"page_size":  settings.PageSize,
+		"sort_by":    settings.SortBy,
+		"sort_order": settings.SortOrder,
+	}
+}
+
+func toplistSettingsToResourceData(settings *v2.ToplistSettings) map[string]any {
+	if settings == nil {
+		settings = v2.NewToplistSettings()
+	}
+	return map[string]any{
+		"limit":      settings.Limit,
+		"sort_order": settings.SortOrder,
+	}
+}
+
+func histogramSettingsToResourceData(settings *v2.HistogramSettings) map[string]any {
+	if settings == nil {
+		settings = v2.NewHistogramSettings()
+	}
+	return map[string]any{
+		"bucket_count": settings.BucketCount,
+	}
+}
+
+func gaugeSettingsToResourceData(settings *v2.GaugeSettings) map[string]any {
+	if settings == nil {
+		settings = v2.NewGaugeSettings()
+	}
+	return map[string]any{
+		"min_value": settings.MinValue,
+		"max_value": settings.MaxValue,
+	}
+}
+
+func barChartSettingsToResourceData(settings *v2.BarChartSettings) map[string]any {
+	if settings == nil {
+		settings = v2.NewBarChartSettings()
+	}
+	return map[string]any{
+		"orientation": settings.Orientation,
+		"stacked":     settings.Stacked,
+	}
+}
+
 func textPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout) (map[string]any, error) {
 	return map[string]any{
 		"pos_x":                  panelLayout.X,
@@ -0,0 +1,148 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSysdigMonitorDashboardFolder() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return teamScopedResource(&schema.Resource{
+		CreateContext: resourceSysdigMonitorDashboardFolderCreate,
+		UpdateContext: resourceSysdigMonitorDashboardFolderUpdate,
+		ReadContext:   resourceSysdigMonitorDashboardFolderRead,
+		DeleteContext: resourceSysdigMonitorDashboardFolderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	})
+}
+
+func getMonitorDashboardFolderClient(c SysdigClients) (v2.DashboardFolderInterface, error) {
+	var client v2.DashboardFolderInterface
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+func resourceSysdigMonitorDashboardFolderCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getMonitorDashboardFolderClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder, err := client.CreateDashboardFolder(ctx, &v2.DashboardFolder{
+		Name: d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(folder.ID))
+
+	return resourceSysdigMonitorDashboardFolderRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorDashboardFolderRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getMonitorDashboardFolderClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder, err := client.GetDashboardFolderByID(ctx, id)
+	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", folder.Name)
+	_ = d.Set("version", folder.Version)
+
+	return nil
+}
+
+func resourceSysdigMonitorDashboardFolderUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getMonitorDashboardFolderClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateDashboardFolder(ctx, &v2.DashboardFolder{
+		ID:      id,
+		Version: d.Get("version").(int),
+		Name:    d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorDashboardFolderRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorDashboardFolderDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getMonitorDashboardFolderClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteDashboardFolder(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDashboardFolder(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardFolder(rText()),
+			},
+			{
+				Config: dashboardFolder(rText()),
+			},
+			{
+				ResourceName:      "sysdig_monitor_dashboard_folder.folder",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func dashboardFolder(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard_folder" "folder" {
+	name = "TERRAFORM TEST - FOLDER %s"
+}
+`, name)
+}
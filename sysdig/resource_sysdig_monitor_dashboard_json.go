@@ -0,0 +1,227 @@
+package sysdig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSysdigMonitorDashboardJSON() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return teamScopedResource(&schema.Resource{
+		CreateContext: resourceSysdigDashboardJSONCreate,
+		UpdateContext: resourceSysdigDashboardJSONUpdate,
+		ReadContext:   resourceSysdigDashboardJSONRead,
+		DeleteContext: resourceSysdigDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				// the server adds the fields left to their default value, so the configured
+				// dashboard only has to be a part of the one read
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldDashboard, err := dashboardFromJSON(old)
+					if err != nil {
+						return false
+					}
+					newDashboard, err := dashboardFromJSON(new)
+					if err != nil {
+						return false
+					}
+					return jsonContains(oldDashboard, newDashboard)
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	})
+}
+
+func resourceSysdigDashboardJSONCreate(ctx context.Context, data *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := dashboardFromJSON(data.Get("dashboard_json").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboardCreated, err := client.CreateDashboardJSON(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, _ := dashboardCreated["id"].(float64)
+	data.SetId(strconv.Itoa(int(id)))
+
+	return resourceSysdigDashboardJSONRead(ctx, data, i)
+}
+
+func resourceSysdigDashboardJSONUpdate(ctx context.Context, data *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := dashboardFromJSON(data.Get("dashboard_json").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, _ := strconv.Atoi(data.Id())
+	dashboard["id"] = id
+	dashboard["version"] = data.Get("version").(int)
+	if publicToken := data.Get("public_token").(string); publicToken != "" {
+		dashboard["publicToken"] = publicToken
+	}
+
+	_, err = client.UpdateDashboardJSON(ctx, id, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigDashboardJSONRead(ctx, data, i)
+}
+
+func resourceSysdigDashboardJSONRead(ctx context.Context, data *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := client.GetDashboardJSONByID(ctx, id)
+	if err != nil {
+		if v2.IsNotFound(err) {
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	name, _ := dashboard["name"].(string)
+	publicToken, _ := dashboard["publicToken"].(string)
+	version, _ := dashboard["version"].(float64)
+	_ = data.Set("name", name)
+	_ = data.Set("public_token", publicToken)
+	_ = data.Set("version", int(version))
+
+	clearDashboardServerFields(dashboard)
+	dashboardJSON, err := json.Marshal(dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = data.Set("dashboard_json", string(dashboardJSON))
+
+	return nil
+}
+
+// dashboardServerFields are the fields of the dashboards populated by the server: their ids,
+// version, timestamps, tokens and owner.
+var dashboardServerFields = []string{
+	"id",
+	"version",
+	"customerId",
+	"teamId",
+	"publicToken",
+	"username",
+	"createdOn",
+	"modifiedOn",
+	"createdOnDate",
+	"modifiedOnDate",
+}
+
+// dashboardFromJSON parses a dashboard as exported from the Sysdig UI, either the dashboard
+// itself or wrapped in a "dashboard" key as returned by the API, without the fields populated
+// by the server. The dashboard is kept as a JSON object so that the fields the provider doesn't
+// model are sent as they are.
+func dashboardFromJSON(dashboardJSON string) (map[string]any, error) {
+	var dashboard map[string]any
+	if err := json.Unmarshal([]byte(dashboardJSON), &dashboard); err != nil {
+		return nil, fmt.Errorf("invalid dashboard JSON: %w", err)
+	}
+	if wrapped, ok := dashboard["dashboard"].(map[string]any); ok {
+		dashboard = wrapped
+	}
+	if name, _ := dashboard["name"].(string); name == "" {
+		return nil, fmt.Errorf("invalid dashboard JSON: the dashboard has no name")
+	}
+
+	clearDashboardServerFields(dashboard)
+	return dashboard, nil
+}
+
+func clearDashboardServerFields(dashboard map[string]any) {
+	for _, field := range dashboardServerFields {
+		delete(dashboard, field)
+	}
+}
+
+// jsonContains reports whether every field of the JSON value part is in whole with the same value,
+// recursively. The arrays must have the same length, their items being compared one by one.
+func jsonContains(whole, part any) bool {
+	switch p := part.(type) {
+	case map[string]any:
+		w, ok := whole.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range p {
+			if !jsonContains(w[key], value) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		w, ok := whole.([]any)
+		if !ok || len(w) != len(p) {
+			return false
+		}
+		for i := range p {
+			if !jsonContains(w[i], p[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(whole, part)
+	}
+}
@@ -0,0 +1,82 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDashboardJSON(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+	name := rText()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardJSON(name, "example panel"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard_json.dashboard", "name", "TERRAFORM TEST - JSON "+name),
+					resource.TestCheckResourceAttrSet("sysdig_monitor_dashboard_json.dashboard", "version"),
+				),
+			},
+			{
+				Config: dashboardJSON(name, "renamed panel"),
+			},
+			{
+				ResourceName:      "sysdig_monitor_dashboard_json.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// dashboardJSON returns a dashboard as exported from the UI, with the fields populated by the server.
+func dashboardJSON(name, panelName string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard_json" "dashboard" {
+	dashboard_json = jsonencode({
+		dashboard = {
+			id          = 1234
+			version     = 7
+			teamId      = 1
+			publicToken = "token"
+			createdOn   = 1700000000000
+			modifiedOn  = 1700000000000
+			username    = "someone@example.com"
+			name        = "TERRAFORM TEST - JSON %s"
+			description = "TERRAFORM TEST - JSON"
+			schema      = 3
+			layout      = [{ x = 0, y = 0, w = 12, h = 6, panelId = 1 }]
+			panels = [{
+				id          = 1
+				name        = "%s"
+				description = ""
+				type        = "advancedTimechart"
+				advancedQueries = [{
+					enabled     = true
+					id          = 1
+					query       = "avg(avg_over_time(sysdig_host_cpu_used_percent[$__interval]))"
+					displayInfo = { displayName = "", timeSeriesDisplayNameTemplate = "", type = "lines" }
+					format = {
+						unit                 = "%%"
+						inputFormat          = "0-100"
+						displayFormat        = "auto"
+						decimals             = 0
+						yAxis                = "auto"
+						minInterval          = ""
+						nullValueDisplayMode = "nullGap"
+					}
+				}]
+			}]
+		}
+	})
+}
+`, name, panelName)
+}
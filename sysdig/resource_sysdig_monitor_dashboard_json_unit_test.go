@@ -0,0 +1,58 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDashboardJSON(t *testing.T) {
+	ctx := context.Background()
+	provider, client := newMockAPIMonitorProvider(t)
+
+	exported := `{"dashboard": {
+		"id": 1234,
+		"version": 7,
+		"username": "someone@example.com",
+		"name": "Cluster overview",
+		"shared": true,
+		"favorite": true,
+		"newUIField": {"enabled": true},
+		"panels": [{"id": 1, "name": "CPU", "type": "advancedTimechart"}]
+	}}`
+
+	resource := resourceSysdigMonitorDashboardJSON()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"dashboard_json": exported})
+	if diags := resource.CreateContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	id, _ := strconv.Atoi(d.Id())
+	stored, err := client.GetDashboardJSONByID(ctx, id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored["shared"] != true || stored["favorite"] != true {
+		t.Errorf("expected the user settings to be sent, got %v", stored)
+	}
+	if field, _ := stored["newUIField"].(map[string]any); field["enabled"] != true {
+		t.Errorf("expected the fields unknown to the provider to be sent, got %v", stored)
+	}
+	if stored["username"] == "someone@example.com" || stored["id"] == float64(1234) {
+		t.Errorf("expected the server fields not to be sent, got %v", stored)
+	}
+
+	suppress := resource.Schema["dashboard_json"].DiffSuppressFunc
+	state := d.Get("dashboard_json").(string)
+	if !suppress("dashboard_json", state, exported, d) {
+		t.Errorf("expected no difference between the exported dashboard and %s", state)
+	}
+	changed := `{"name": "Cluster overview", "shared": false, "newUIField": {"enabled": true}, "panels": [{"name": "CPU", "type": "advancedTimechart"}]}`
+	if suppress("dashboard_json", state, changed, d) {
+		t.Errorf("expected a difference when a user setting changes")
+	}
+}
@@ -10,21 +10,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 func TestAccDashboard(t *testing.T) {
 	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: minimumDashboard(rText()),
@@ -54,9 +47,61 @@ func TestAccDashboard(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: sharedByNameDashboard(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sysdig_monitor_dashboard.dashboard", "folder_id", "sysdig_monitor_dashboard_folder.folder", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("sysdig_monitor_dashboard.dashboard", "share.*", map[string]string{
+						"role":          "ROLE_RESOURCE_READ",
+						"member.0.type": "TEAM",
+						"member.0.id":   "0",
+					}),
+				),
+			},
 			{
 				Config: multiplePanelsDashboardWithDisplayInfo(rText()),
 			},
+			{
+				Config: templatedDashboard(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("sysdig_monitor_dashboard.dashboard", "scope.*", map[string]string{
+						"variable":     "namespace",
+						"values_query": "kube_namespace_labels{kube_cluster_name=$cluster}",
+						"comparator":   "in",
+						"value.0":      "default",
+					}),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "event_display.0.enabled", "true"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "event_display.0.severities.#", "2"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "event_display.0.filter", "kube_namespace_name in ($namespace)"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_dashboard.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: settingsPanelsDashboard(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.#", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs("sysdig_monitor_dashboard.dashboard", "panel.*", map[string]string{
+						"type":               "table",
+						"table.0.page_size":  "25",
+						"table.0.sort_by":    "pod",
+						"table.0.sort_order": "asc",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sysdig_monitor_dashboard.dashboard", "panel.*", map[string]string{
+						"type":                    "bar_chart",
+						"bar_chart.0.orientation": "horizontal",
+						"bar_chart.0.stacked":     "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_dashboard.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: timeChartDashboardWithLegend(
 					rText(),
@@ -370,6 +415,146 @@ resource "sysdig_monitor_dashboard" "dashboard" {
 `, name, name)
 }
 
+func templatedDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+
+	scope {
+		metric = "kube_cluster_name"
+		variable = "cluster"
+	}
+
+	scope {
+		metric = "kube_namespace_name"
+		comparator = "in"
+		value = ["default"]
+		variable = "namespace"
+		values_query = "kube_namespace_labels{kube_cluster_name=$cluster}"
+	}
+
+	event_display {
+		filter = "kube_namespace_name in ($namespace)"
+		severities = ["high", "medium"]
+	}
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "timechart"
+		name = "example panel"
+
+		query {
+			promql = "sum(sysdig_container_cpu_used_percent{kube_namespace_name=$namespace})"
+			unit = "percent"
+		}
+	}
+}
+`, name, name)
+}
+
+func settingsPanelsDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "table"
+		name = "table panel"
+
+		table {
+			page_size = 25
+			sort_by = "pod"
+			sort_order = "asc"
+		}
+
+		query {
+			promql = "sum by (pod) (sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+	}
+
+	panel {
+		pos_x = 12
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "toplist"
+		name = "toplist panel"
+
+		toplist {
+			limit = 5
+		}
+
+		query {
+			promql = "topk(5, sysdig_container_memory_used_bytes)"
+			unit = "data"
+		}
+	}
+
+	panel {
+		pos_x = 0
+		pos_y = 6
+		width = 8
+		height = 6
+		type = "histogram"
+		name = "histogram panel"
+
+		query {
+			promql = "sysdig_container_cpu_used_percent"
+			unit = "percent"
+		}
+	}
+
+	panel {
+		pos_x = 8
+		pos_y = 6
+		width = 8
+		height = 6
+		type = "gauge"
+		name = "gauge panel"
+
+		gauge {
+			min_value = 0
+			max_value = 1
+		}
+
+		query {
+			promql = "avg(sysdig_host_cpu_used_percent) / 100"
+			unit = "number"
+		}
+	}
+
+	panel {
+		pos_x = 16
+		pos_y = 6
+		width = 8
+		height = 6
+		type = "bar_chart"
+		name = "bar chart panel"
+
+		bar_chart {
+			orientation = "horizontal"
+			stacked = true
+		}
+
+		query {
+			promql = "sum by (kube_namespace_name) (sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+	}
+}
+`, name, name)
+}
+
 func minimumNumberDashboard(name string) string {
 	return fmt.Sprintf(`
 resource "sysdig_monitor_dashboard" "dashboard_2" {
@@ -675,6 +860,49 @@ resource "sysdig_monitor_dashboard" "dashboard" {
 `, name, name, name)
 }
 
+func sharedByNameDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_team" "a_team" {
+  name      = "sample-%s"
+
+  entrypoint {
+	type = "Explore"
+  }
+}
+
+resource "sysdig_monitor_dashboard_folder" "folder" {
+	name = "TERRAFORM TEST - FOLDER %s"
+}
+
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+	folder_id = sysdig_monitor_dashboard_folder.folder.id
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "number"
+		name = "example panel"
+
+		query {
+			promql = "avg(avg_over_time(sysdig_host_cpu_used_percent[$__interval]))"
+			unit = "percent"
+		}
+	}
+	share {
+		role = "ROLE_RESOURCE_READ"
+		member {
+			type = "TEAM"
+			name = sysdig_monitor_team.a_team.name
+		}
+	}
+}
+`, name, name, name, name)
+}
+
 func multiplePanelsDashboardWithDisplayInfo(name string) string {
 	return fmt.Sprintf(`
 resource "sysdig_monitor_dashboard" "dashboard" {
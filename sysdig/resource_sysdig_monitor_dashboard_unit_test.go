@@ -0,0 +1,51 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestDashboardShareMemberResolvedID(t *testing.T) {
+	ctx := context.Background()
+	provider, client := newMockAPIMonitorProvider(t)
+
+	team, err := client.CreateTeam(ctx, v2.Team{Name: "payments"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resource := resourceSysdigMonitorDashboard()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{
+		"name": "payments",
+		"share": []any{map[string]any{
+			"role":   "ROLE_RESOURCE_READ",
+			"member": []any{map[string]any{"type": "TEAM", "name": "payments"}},
+		}},
+	})
+	if diags := resource.CreateContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// the refreshes match the members by the stored ID, even once the team can't be found
+	if err := client.DeleteTeam(ctx, team.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diags := resource.ReadContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	shares := d.Get("share").(*schema.Set).List()
+	if len(shares) != 1 {
+		t.Fatalf("expected a share, got %v", shares)
+	}
+	member := shares[0].(map[string]any)["member"].(*schema.Set).List()[0].(map[string]any)
+	if member["name"] != "payments" || member["id"] != 0 || member["resolved_id"] != team.ID {
+		t.Errorf("expected the team to be kept by name with its resolved ID %d, got %v", team.ID, member)
+	}
+}
@@ -154,7 +154,7 @@ func resourceSysdigMonitorInhibitionRuleRead(ctx context.Context, d *schema.Reso
 
 	inhibitionRule, err := client.GetInhibitionRuleByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrAlertV2NotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -1,110 +1,361 @@
 package sysdig
 
 import (
+	"context"
+	"fmt"
 	"maps"
+	"strconv"
+	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func createMonitorNotificationChannelSchema(original map[string]*schema.Schema) map[string]*schema.Schema {
-	notificationChannelSchema := map[string]*schema.Schema{
-		"name": {
-			Type:     schema.TypeString,
+const monitorNotificationChannelTimeout = 5 * time.Minute
+
+// monitorNotificationChannelModel holds the attributes shared by every
+// sysdig_monitor_notification_channel_* resource. Each channel type embeds it.
+type monitorNotificationChannelModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	Enabled              types.Bool     `tfsdk:"enabled"`
+	ShareWithCurrentTeam types.Bool     `tfsdk:"share_with_current_team"`
+	NotifyWhenOk         types.Bool     `tfsdk:"notify_when_ok"`
+	NotifyWhenResolved   types.Bool     `tfsdk:"notify_when_resolved"`
+	Version              types.Int64    `tfsdk:"version"`
+	SendTestNotification types.Bool     `tfsdk:"send_test_notification"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *monitorNotificationChannelModel) common() *monitorNotificationChannelModel {
+	return m
+}
+
+// monitorNotificationChannelTypedModel is implemented by the model of each channel type,
+// converting its type specific attributes from and to the API options.
+type monitorNotificationChannelTypedModel interface {
+	common() *monitorNotificationChannelModel
+	toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) diag.Diagnostics
+	fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) diag.Diagnostics
+}
+
+// productScopedModel is implemented by the models of the channels which can be managed
+// through the API of either product, Monitor being the default one.
+type productScopedModel interface {
+	product() string
+}
+
+// monitorNotificationChannelResource implements the CRUD lifecycle shared by all
+// the Monitor notification channel types on top of the plugin framework.
+type monitorNotificationChannelResource struct {
+	clients SysdigClients
+
+	typeName    string
+	channelType string
+	attributes  map[string]schema.Attribute
+	newModel    func() monitorNotificationChannelTypedModel
+}
+
+var (
+	_ resource.ResourceWithConfigure   = &monitorNotificationChannelResource{}
+	_ resource.ResourceWithImportState = &monitorNotificationChannelResource{}
+)
+
+func (r *monitorNotificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_monitor_notification_channel_%s", req.ProviderTypeName, r.typeName)
+}
+
+func (r *monitorNotificationChannelResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": schema.StringAttribute{
 			Required: true,
 		},
-		"enabled": {
-			Type:     schema.TypeBool,
+		"enabled": schema.BoolAttribute{
 			Optional: true,
-			Default:  true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
 		},
-		"share_with_current_team": {
-			Type:     schema.TypeBool,
+		"share_with_current_team": schema.BoolAttribute{
 			Optional: true,
-			Default:  false,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
 		},
-		"notify_when_ok": {
-			Type:       schema.TypeBool,
-			Optional:   true,
-			Default:    false,
-			Deprecated: "The notify_when_ok field is deprecated and will be removed in a future version. This flag has been replaced by the `notify_on_resolve` field inside `notification_channels` when defining an alert resource.",
+		"notify_when_ok": schema.BoolAttribute{
+			Optional:           true,
+			Computed:           true,
+			Default:            booldefault.StaticBool(false),
+			DeprecationMessage: "The notify_when_ok field is deprecated and will be removed in a future version. This flag has been replaced by the `notify_on_resolve` field inside `notification_channels` when defining an alert resource.",
 		},
-		"notify_when_resolved": {
-			Type:       schema.TypeBool,
-			Optional:   true,
-			Default:    false,
-			Deprecated: "The notify_when_resolved field is deprecated and will be removed in a future version. This flag has been replaced by the `notify_on_acknowledge` field inside `notification_channels` when defining an alert resource.",
+		"notify_when_resolved": schema.BoolAttribute{
+			Optional:           true,
+			Computed:           true,
+			Default:            booldefault.StaticBool(false),
+			DeprecationMessage: "The notify_when_resolved field is deprecated and will be removed in a future version. This flag has been replaced by the `notify_on_acknowledge` field inside `notification_channels` when defining an alert resource.",
 		},
-		"version": {
-			Type:     schema.TypeInt,
+		"version": schema.Int64Attribute{
 			Computed: true,
 		},
-		"send_test_notification": {
-			Type:     schema.TypeBool,
+		"send_test_notification": schema.BoolAttribute{
 			Optional: true,
-			Default:  false,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
 		},
 	}
 
-	maps.Copy(notificationChannelSchema, original)
+	maps.Copy(attributes, r.attributes)
+	if _, ok := r.newModel().(teamScopedModel); ok {
+		maps.Copy(attributes, teamOverrideAttributes())
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
 
-	return notificationChannelSchema
+func (r *monitorNotificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.clients = configureFrameworkResource(req, resp)
 }
 
-func monitorNotificationChannelFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	var tID *int
-	shareWithCurrentTeam := d.Get("share_with_current_team").(bool)
-	if shareWithCurrentTeam {
-		tID = &teamID
+func (r *monitorNotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	nc = v2.NotificationChannel{
-		Name:    d.Get("name").(string),
-		Enabled: d.Get("enabled").(bool),
-		TeamID:  tID,
-		Options: v2.NotificationChannelOptions{
-			NotifyOnOk:           d.Get("notify_when_ok").(bool),
-			NotifyOnResolve:      d.Get("notify_when_resolved").(bool),
-			SendTestNotification: d.Get("send_test_notification").(bool),
-		},
+	timeout, diags := model.common().Timeouts.Create(ctx, monitorNotificationChannelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(contextWithTeamOverride(ctx, model), timeout)
+	defer cancel()
+
+	client, err := r.client(model)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating notification channel", err.Error())
+		return
 	}
-	return nc, err
-}
 
-func monitorNotificationChannelToResourceData(nc *v2.NotificationChannel, data *schema.ResourceData) (err error) {
-	_ = data.Set("version", nc.Version)
-	_ = data.Set("name", nc.Name)
-	_ = data.Set("enabled", nc.Enabled)
-	var shareWithCurrentTeam bool
-	if nc.TeamID != nil {
-		shareWithCurrentTeam = true
+	nc, diags := r.notificationChannelFromModel(ctx, client, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err = data.Set("share_with_current_team", shareWithCurrentTeam)
+	nc, err = client.CreateNotificationChannel(ctx, nc)
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("Error creating notification channel", err.Error())
+		return
 	}
-	_ = data.Set("notify_when_ok", nc.Options.NotifyOnOk)
-	_ = data.Set("notify_when_resolved", nc.Options.NotifyOnResolve)
-	// do not update "send_test_notification" from the api response as it will always be "false" on subsequent reads because the fields is not persisted
 
-	return err
+	resp.Diagnostics.Append(r.notificationChannelToModel(ctx, &nc, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
-func getMonitorNotificationChannelClient(c SysdigClients) (v2.NotificationChannelInterface, error) {
-	var client v2.NotificationChannelInterface
-	var err error
-	switch c.GetClientType() {
-	case IBMMonitor:
-		client, err = c.ibmMonitorClient()
-		if err != nil {
-			return nil, err
+func (r *monitorNotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.common().Timeouts.Read(ctx, monitorNotificationChannelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(contextWithTeamOverride(ctx, model), timeout)
+	defer cancel()
+
+	client, err := r.client(model)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading notification channel", err.Error())
+		return
+	}
+
+	id, err := strconv.Atoi(model.common().ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid notification channel ID", err.Error())
+		return
+	}
+
+	nc, err := client.GetNotificationChannelByID(ctx, id)
+	if err != nil {
+		if v2.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-	default:
-		client, err = c.sysdigMonitorClientV2()
+		resp.Diagnostics.AddError("Error reading notification channel", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.notificationChannelToModel(ctx, &nc, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *monitorNotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	state := r.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.common().Timeouts.Update(ctx, monitorNotificationChannelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(contextWithTeamOverride(ctx, model), timeout)
+	defer cancel()
+
+	client, err := r.client(model)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating notification channel", err.Error())
+		return
+	}
+
+	nc, diags := r.notificationChannelFromModel(ctx, client, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nc.ID, err = strconv.Atoi(state.common().ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid notification channel ID", err.Error())
+		return
+	}
+	nc.Version = int(state.common().Version.ValueInt64())
+
+	nc, err = client.UpdateNotificationChannel(ctx, nc)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating notification channel", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.notificationChannelToModel(ctx, &nc, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *monitorNotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.common().Timeouts.Delete(ctx, monitorNotificationChannelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(contextWithTeamOverride(ctx, model), timeout)
+	defer cancel()
+
+	client, err := r.client(model)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting notification channel", err.Error())
+		return
+	}
+
+	id, err := strconv.Atoi(model.common().ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid notification channel ID", err.Error())
+		return
+	}
+
+	err = client.DeleteNotificationChannel(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting notification channel", err.Error())
+	}
+}
+
+func (r *monitorNotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *monitorNotificationChannelResource) client(model monitorNotificationChannelTypedModel) (v2.NotificationChannelInterface, error) {
+	if m, ok := model.(productScopedModel); ok && m.product() == "secure" {
+		return getSecureNotificationChannelClient(r.clients)
+	}
+	return getMonitorNotificationChannelClient(r.clients)
+}
+
+func (r *monitorNotificationChannelResource) notificationChannelFromModel(ctx context.Context, client v2.NotificationChannelInterface, model monitorNotificationChannelTypedModel) (v2.NotificationChannel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	m := model.common()
+
+	var teamID *int
+	if m.ShareWithCurrentTeam.ValueBool() {
+		currentTeamID, err := client.CurrentTeamID(ctx)
 		if err != nil {
-			return nil, err
+			diags.AddError("Error getting current team", err.Error())
+			return v2.NotificationChannel{}, diags
 		}
+		teamID = &currentTeamID
+	}
+
+	nc := v2.NotificationChannel{
+		Type:    r.channelType,
+		Name:    m.Name.ValueString(),
+		Enabled: m.Enabled.ValueBool(),
+		TeamID:  teamID,
+		Options: v2.NotificationChannelOptions{
+			NotifyOnOk:           m.NotifyWhenOk.ValueBool(),
+			NotifyOnResolve:      m.NotifyWhenResolved.ValueBool(),
+			SendTestNotification: m.SendTestNotification.ValueBool(),
+		},
+	}
+
+	diags.Append(model.toNotificationChannel(ctx, &nc)...)
+	return nc, diags
+}
+
+func (r *monitorNotificationChannelResource) notificationChannelToModel(ctx context.Context, nc *v2.NotificationChannel, model monitorNotificationChannelTypedModel) diag.Diagnostics {
+	m := model.common()
+	m.ID = types.StringValue(strconv.Itoa(nc.ID))
+	m.Version = types.Int64Value(int64(nc.Version))
+	m.Name = types.StringValue(nc.Name)
+	m.Enabled = types.BoolValue(nc.Enabled)
+	m.ShareWithCurrentTeam = types.BoolValue(nc.TeamID != nil)
+	m.NotifyWhenOk = types.BoolValue(nc.Options.NotifyOnOk)
+	m.NotifyWhenResolved = types.BoolValue(nc.Options.NotifyOnResolve)
+	// "send_test_notification" is not persisted by the API, keep whatever was configured
+	if m.SendTestNotification.IsNull() || m.SendTestNotification.IsUnknown() {
+		m.SendTestNotification = types.BoolValue(false)
 	}
-	return client, nil
+
+	return model.fromNotificationChannel(ctx, nc)
 }
@@ -2,186 +2,83 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelCustomWebhook() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelCustomWebhookCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelCustomWebhookUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelCustomWebhookRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelCustomWebhookDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelCustomWebhookModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelCustomWebhookModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelCustomWebhookModel struct {
+	URL                      types.String `tfsdk:"url"`
+	HTTPMethod               types.String `tfsdk:"http_method"`
+	Template                 types.String `tfsdk:"template"`
+	RenderedExample          types.String `tfsdk:"rendered_example"`
+	AllowInsecureConnections types.Bool   `tfsdk:"allow_insecure_connections"`
+	AdditionalHeaders        types.Map    `tfsdk:"additional_headers"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"url": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelCustomWebhookResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "custom_webhook",
+		channelType: notificationChannelTypeCustomWebhook,
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
 				Required: true,
 			},
-			"http_method": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"POST", "PUT", "PATCH", "DELETE"}, false),
+			"http_method": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.OneOf("POST", "PUT", "PATCH", "DELETE")},
 			},
-			"template": {
-				Type:     schema.TypeString,
-				Required: true,
+			"template": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{notificationChannelTemplateValidator{product: "Monitor", variables: monitorNotificationTemplateVariables}},
 			},
-			"allow_insecure_connections": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+			"rendered_example": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{renderedTemplatePlanModifier{template: "template"}},
 			},
-			"additional_headers": {
-				Type:     schema.TypeMap,
+			"allow_insecure_connections": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelCustomWebhookCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	clients := meta.(SysdigClients)
-	client, err := getMonitorNotificationChannelClient(clients)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelCustomWebhookFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelCustomWebhookRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelCustomWebhookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelCustomWebhookToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func resourceSysdigMonitorNotificationChannelCustomWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelCustomWebhookFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func resourceSysdigMonitorNotificationChannelCustomWebhookDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
+			"additional_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelCustomWebhookModel{} },
 	}
-
-	return nil
 }
 
-func monitorNotificationChannelCustomWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeCustomWebhook
-	nc.Options.URL = d.Get("url").(string)
-	nc.Options.HTTPMethod = d.Get("http_method").(string)
-	nc.Options.MonitorTemplate = d.Get("template").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]any)
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
+func (m *notificationChannelCustomWebhookModel) toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	nc.Options.URL = m.URL.ValueString()
+	nc.Options.HTTPMethod = m.HTTPMethod.ValueString()
+	nc.Options.MonitorTemplate = m.Template.ValueString()
+	allowInsecureConnections := m.AllowInsecureConnections.ValueBool()
 	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return nc, err
+	nc.Options.AdditionalHeaders, diags = stringMapFromModel(ctx, m.AdditionalHeaders)
+	return diags
 }
 
-func monitorNotificationChannelCustomWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("url", nc.Options.URL)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-	_ = d.Set("http_method", nc.Options.HTTPMethod)
-	_ = d.Set("template", nc.Options.MonitorTemplate)
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return err
+func (m *notificationChannelCustomWebhookModel) fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	m.URL = types.StringValue(nc.Options.URL)
+	m.HTTPMethod = types.StringValue(nc.Options.HTTPMethod)
+	m.Template = types.StringValue(nc.Options.MonitorTemplate)
+	m.RenderedExample = types.StringValue(renderNotificationChannelTemplate(nc.Options.MonitorTemplate, monitorNotificationTemplateVariables))
+	m.AllowInsecureConnections = optionalBoolPointer(nc.Options.AllowInsecureConnections, m.AllowInsecureConnections, false)
+	m.AdditionalHeaders, diags = optionalStringMap(ctx, nc.Options.AdditionalHeaders, m.AdditionalHeaders)
+	return diags
 }
@@ -8,24 +8,30 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 )
 
 func TestAccMonitorNotificationChannelCustomWebhook(t *testing.T) {
 	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: sysdigOrIBMMonitorPreCheck(t),
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
 		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelCustomWebhookWithGoTemplate(rText()),
+				Check: resource.TestCheckResourceAttr(
+					"sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook1",
+					"rendered_example",
+					"{\"priority\": \"P1\"}",
+				),
+			},
 			{
 				Config: monitorNotificationChannelCustomWebhookWithName(rText()),
+				Check: resource.TestCheckResourceAttr(
+					"sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook1",
+					"rendered_example",
+					"{\n  \"code\": \"incident\",\n  \"alert\": \"High CPU usage\"\n}",
+				),
 			},
 			{
 				ResourceName:            "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook1",
@@ -150,3 +156,13 @@ func monitorNotificationChannelCustomWebhookSharedWithAdditionalHeaders(name str
 		send_test_notification = false
 	}`, name)
 }
+
+func monitorNotificationChannelCustomWebhookWithGoTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_custom_webhook" "sample-custom-webhook1" {
+	name = "Example Channel %s - Custom Webhook"
+	url = "https://example.com/"
+	http_method = "POST"
+	template = "{\"priority\": \"{{ if eq @alert_severity \"high\" }}P1{{ else }}P3{{ end }}\"}"
+}`, name)
+}
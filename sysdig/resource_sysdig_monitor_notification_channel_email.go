@@ -2,157 +2,43 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/spf13/cast"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelEmail() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelEmailCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelEmailUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelEmailRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelEmailDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
-
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"recipients": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Required: true,
-			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelEmailCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelEmailFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelEmailRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelEmailRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelEmailToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+type monitorNotificationChannelEmailModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelEmailModel
 }
 
-func resourceSysdigMonitorNotificationChannelEmailUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelEmailFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+type notificationChannelEmailModel struct {
+	Recipients types.Set `tfsdk:"recipients"`
 }
 
-func resourceSysdigMonitorNotificationChannelEmailDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
+func newMonitorNotificationChannelEmailResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "email",
+		channelType: notificationChannelTypeEmail,
+		attributes: map[string]schema.Attribute{
+			"recipients": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelEmailModel{} },
 	}
-
-	return nil
 }
 
-func monitorNotificationChannelEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeEmail
-	nc.Options.EmailRecipients = cast.ToStringSlice(d.Get("recipients").(*schema.Set).List())
-	return nc, err
+func (m *notificationChannelEmailModel) toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	return m.Recipients.ElementsAs(ctx, &nc.Options.EmailRecipients, false)
 }
 
-func monitorNotificationChannelEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("recipients", nc.Options.EmailRecipients)
-
-	return err
+func (m *notificationChannelEmailModel) fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	m.Recipients, diags = types.SetValueFrom(ctx, types.StringType, nc.Options.EmailRecipients)
+	return diags
 }
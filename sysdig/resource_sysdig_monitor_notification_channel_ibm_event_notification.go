@@ -2,164 +2,45 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelIBMEventNotification() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelIBMEventNotificationCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelIBMEventNotificationUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelIBMEventNotificationRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelIBMEventNotificationDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelIBMEventNotificationModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelIBMEventNotificationModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelIBMEventNotificationModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"instance_id": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelIBMEventNotificationResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "ibm_event_notification",
+		channelType: notificationChannelTypeIBMEventNotification,
+		attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
 				Required: true,
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelIBMEventNotificationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelIBMEventNotificationFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel {
+			return &monitorNotificationChannelIBMEventNotificationModel{}
+		},
 	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelIBMEventNotificationRead(ctx, d, meta)
 }
 
-func resourceSysdigMonitorNotificationChannelIBMEventNotificationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelIBMEventNotificationToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelIBMEventNotificationModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.InstanceID = m.InstanceID.ValueString()
 	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelIBMEventNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelIBMEventNotificationFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, err = strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceSysdigMonitorNotificationChannelIBMEventNotificationRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelIBMEventNotificationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelIBMEventNotificationModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.InstanceID = types.StringValue(nc.Options.InstanceID)
 	return nil
 }
-
-func monitorNotificationChannelIBMEventNotificationFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeIBMEventNotification
-	nc.Options.InstanceID = d.Get("instance_id").(string)
-	return nc, err
-}
-
-func monitorNotificationChannelIBMEventNotificationToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("instance_id", nc.Options.InstanceID)
-
-	return err
-}
@@ -0,0 +1,90 @@
+package sysdig
+
+import (
+	"context"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type monitorNotificationChannelJiraModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelJiraModel
+}
+
+type notificationChannelJiraModel struct {
+	URL       types.String `tfsdk:"url"`
+	Project   types.String `tfsdk:"project"`
+	IssueType types.String `tfsdk:"issue_type"`
+	User      types.String `tfsdk:"user"`
+	APIToken  types.String `tfsdk:"api_token"`
+	Assignee  types.String `tfsdk:"assignee"`
+	Labels    types.Set    `tfsdk:"labels"`
+}
+
+func newMonitorNotificationChannelJiraResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "jira",
+		channelType: notificationChannelTypeJira,
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				Required: true,
+			},
+			"issue_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Task"),
+			},
+			"user": schema.StringAttribute{
+				Required: true,
+			},
+			"api_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"assignee": schema.StringAttribute{
+				Optional: true,
+			},
+			"labels": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelJiraModel{} },
+	}
+}
+
+func (m *notificationChannelJiraModel) toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.URL = m.URL.ValueString()
+	nc.Options.Project = m.Project.ValueString()
+	nc.Options.IssueType = m.IssueType.ValueString()
+	nc.Options.User = m.User.ValueString()
+	nc.Options.APIToken = m.APIToken.ValueString()
+	nc.Options.Assignee = m.Assignee.ValueString()
+	if m.Labels.IsNull() || m.Labels.IsUnknown() {
+		return nil
+	}
+	return m.Labels.ElementsAs(ctx, &nc.Options.Labels, false)
+}
+
+func (m *notificationChannelJiraModel) fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	m.URL = types.StringValue(nc.Options.URL)
+	m.Project = types.StringValue(nc.Options.Project)
+	m.IssueType = types.StringValue(nc.Options.IssueType)
+	m.User = types.StringValue(nc.Options.User)
+	m.APIToken = types.StringValue(nc.Options.APIToken)
+	m.Assignee = optionalString(nc.Options.Assignee, m.Assignee)
+	if len(nc.Options.Labels) == 0 && m.Labels.IsNull() {
+		return nil
+	}
+	m.Labels, diags = types.SetValueFrom(ctx, types.StringType, nc.Options.Labels)
+	return diags
+}
@@ -0,0 +1,67 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMonitorNotificationChannelJira(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelJiraWithName(rText()),
+			},
+			{
+				Config: monitorNotificationChannelJiraShareWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_jira.sample-jira",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelJiraWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_jira" "sample-jira" {
+	name = "Example Channel %s - Jira"
+	enabled = true
+	url = "https://example.atlassian.net"
+	project = "OPS"
+	user = "jira@example.com"
+	api_token = "ATATT3xFfGF0"
+	labels = ["sysdig"]
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
+
+func monitorNotificationChannelJiraShareWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_jira" "sample-jira" {
+	name = "Example Channel %s - Jira"
+	share_with_current_team = true
+	enabled = true
+	url = "https://example.atlassian.net"
+	project = "OPS"
+	user = "jira@example.com"
+	api_token = "ATATT3xFfGF0"
+	labels = ["sysdig"]
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
@@ -2,164 +2,43 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelMSTeams() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelMSTeamsCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelMSTeamsUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelMSTeamsRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelMSTeamsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelMSTeamsModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelMSTeamsModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelMSTeamsModel struct {
+	URL types.String `tfsdk:"url"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"url": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelMSTeamsResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "msteams",
+		channelType: notificationChannelTypeMSTeams,
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
 				Required: true,
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelMSTeamsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelMSTeamsFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelMSTeamsModel{} },
 	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelMSTeamsRead(ctx, d, meta)
 }
 
-func resourceSysdigMonitorNotificationChannelMSTeamsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelMSTeamsToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelMSTeamsModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.URL = m.URL.ValueString()
 	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelMSTeamsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelMSTeamsFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, err = strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceSysdigMonitorNotificationChannelMSTeamsRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelMSTeamsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelMSTeamsModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.URL = types.StringValue(nc.Options.URL)
 	return nil
 }
-
-func monitorNotificationChannelMSTeamsFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeMSTeams
-	nc.Options.URL = d.Get("url").(string)
-	return nc, err
-}
-
-func monitorNotificationChannelMSTeamsToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("url", nc.Options.URL)
-
-	return err
-}
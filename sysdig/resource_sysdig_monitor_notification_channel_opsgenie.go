@@ -2,165 +2,55 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelOpsGenie() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelOpsGenieCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelOpsGenieUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelOpsGenieRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelOpsGenieDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelOpsGenieModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelOpsGenieModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelOpsGenieModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+	Region types.String `tfsdk:"region"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"api_key": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelOpsGenieResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "opsgenie",
+		channelType: notificationChannelTypeOpsGenie,
+		attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
 				Required: true,
 			},
-			"region": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "US",
-				ValidateFunc: validation.StringInSlice([]string{"US", "EU"}, false),
+			"region": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("US"),
+				Validators: []validator.String{stringvalidator.OneOf("US", "EU")},
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelOpsGenieCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelOpsGenieFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelOpsGenieModel{} },
 	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelOpsGenieRead(ctx, d, meta)
 }
 
-func resourceSysdigMonitorNotificationChannelOpsGenieRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelOpsGenieToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelOpsGenieModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.APIKey = m.APIKey.ValueString()
+	nc.Options.Region = m.Region.ValueString()
 	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelOpsGenieUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelOpsGenieFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelOpsGenieModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.APIKey = types.StringValue(nc.Options.APIKey)
+	m.Region = types.StringValue(nc.Options.Region)
 	return nil
 }
-
-func resourceSysdigMonitorNotificationChannelOpsGenieDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func monitorNotificationChannelOpsGenieFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeOpsGenie
-	apiKey := d.Get("api_key").(string)
-	nc.Options.APIKey = apiKey
-	nc.Options.Region = d.Get("region").(string)
-	return nc, err
-}
-
-func monitorNotificationChannelOpsGenieToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("api_key", nc.Options.APIKey)
-	_ = d.Set("region", nc.Options.Region)
-
-	return err
-}
@@ -2,168 +2,55 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelPagerduty() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelPagerdutyCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelPagerdutyUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelPagerdutyRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelPagerdutyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelPagerdutyModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelPagerdutyModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelPagerdutyModel struct {
+	Account     types.String `tfsdk:"account"`
+	ServiceKey  types.String `tfsdk:"service_key"`
+	ServiceName types.String `tfsdk:"service_name"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"account": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelPagerdutyResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "pagerduty",
+		channelType: notificationChannelTypePagerduty,
+		attributes: map[string]schema.Attribute{
+			"account": schema.StringAttribute{
 				Required: true,
 			},
-			"service_key": {
-				Type:     schema.TypeString,
+			"service_key": schema.StringAttribute{
 				Required: true,
 			},
-			"service_name": {
-				Type:     schema.TypeString,
+			"service_name": schema.StringAttribute{
 				Required: true,
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelPagerdutyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelPagerdutyFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelPagerdutyRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelPagerdutyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelPagerdutyToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelPagerdutyModel{} },
 	}
-
-	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelPagerdutyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelPagerdutyFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelPagerdutyModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.Account = m.Account.ValueString()
+	nc.Options.ServiceKey = m.ServiceKey.ValueString()
+	nc.Options.ServiceName = m.ServiceName.ValueString()
 	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelPagerdutyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelPagerdutyModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.Account = types.StringValue(nc.Options.Account)
+	m.ServiceKey = types.StringValue(nc.Options.ServiceKey)
+	m.ServiceName = types.StringValue(nc.Options.ServiceName)
 	return nil
 }
-
-func monitorNotificationChannelPagerdutyFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypePagerduty
-	nc.Options.Account = d.Get("account").(string)
-	nc.Options.ServiceKey = d.Get("service_key").(string)
-	nc.Options.ServiceName = d.Get("service_name").(string)
-
-	return nc, err
-}
-
-func monitorNotificationChannelPagerdutyToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("account", nc.Options.Account)
-	_ = d.Set("service_key", nc.Options.ServiceKey)
-	_ = d.Set("service_name", nc.Options.ServiceName)
-
-	return err
-}
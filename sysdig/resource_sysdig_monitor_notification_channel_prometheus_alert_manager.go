@@ -2,172 +2,62 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelPrometheusAlertManager() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelPrometheusAlertManagerCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelPrometheusAlertManagerUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelPrometheusAlertManagerRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelPrometheusAlertManagerDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelPrometheusAlertManagerModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelPrometheusAlertManagerModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelPrometheusAlertManagerModel struct {
+	URL                      types.String `tfsdk:"url"`
+	AdditionalHeaders        types.Map    `tfsdk:"additional_headers"`
+	AllowInsecureConnections types.Bool   `tfsdk:"allow_insecure_connections"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"url": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelPrometheusAlertManagerResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "prometheus_alert_manager",
+		channelType: notificationChannelTypePrometheusAlertManager,
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
 				Required: true,
 			},
-			"additional_headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+			"additional_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
 			},
-			"allow_insecure_connections": {
-				Type:     schema.TypeBool,
+			"allow_insecure_connections": schema.BoolAttribute{
 				Optional: true,
-				Default:  false,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelPrometheusAlertManagerRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelPrometheusAlertManagerToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel {
+			return &monitorNotificationChannelPrometheusAlertManagerModel{}
+		},
 	}
-
-	return nil
 }
 
-func monitorNotificationChannelPrometheusAlertManagerFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypePrometheusAlertManager
-	nc.Options.URL = d.Get("url").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]any)
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
+func (m *notificationChannelPrometheusAlertManagerModel) toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	nc.Options.URL = m.URL.ValueString()
+	allowInsecureConnections := m.AllowInsecureConnections.ValueBool()
 	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return nc, err
+	nc.Options.AdditionalHeaders, diags = stringMapFromModel(ctx, m.AdditionalHeaders)
+	return diags
 }
 
-func monitorNotificationChannelPrometheusAlertManagerToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("url", nc.Options.URL)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return err
+func (m *notificationChannelPrometheusAlertManagerModel) fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	m.URL = types.StringValue(nc.Options.URL)
+	m.AllowInsecureConnections = optionalBoolPointer(nc.Options.AllowInsecureConnections, m.AllowInsecureConnections, false)
+	m.AdditionalHeaders, diags = optionalStringMap(ctx, nc.Options.AdditionalHeaders, m.AdditionalHeaders)
+	return diags
 }
@@ -0,0 +1,57 @@
+package sysdig
+
+import (
+	"context"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type monitorNotificationChannelServiceNowModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelServiceNowModel
+}
+
+type notificationChannelServiceNowModel struct {
+	URL      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func newMonitorNotificationChannelServiceNowResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "servicenow",
+		channelType: notificationChannelTypeServiceNow,
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required: true,
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelServiceNowModel{} },
+	}
+}
+
+func (m *notificationChannelServiceNowModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.URL = m.URL.ValueString()
+	nc.Options.User = m.Username.ValueString()
+	nc.Options.Password = m.Password.ValueString()
+	return nil
+}
+
+func (m *notificationChannelServiceNowModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.URL = types.StringValue(nc.Options.URL)
+	m.Username = types.StringValue(nc.Options.User)
+	m.Password = types.StringValue(nc.Options.Password)
+	return nil
+}
@@ -0,0 +1,63 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMonitorNotificationChannelServiceNow(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelServiceNowWithName(rText()),
+			},
+			{
+				Config: monitorNotificationChannelServiceNowShareWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_servicenow.sample-servicenow",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelServiceNowWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_servicenow" "sample-servicenow" {
+	name = "Example Channel %s - ServiceNow"
+	enabled = true
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "changeme"
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
+
+func monitorNotificationChannelServiceNowShareWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_servicenow" "sample-servicenow" {
+	name = "Example Channel %s - ServiceNow"
+	share_with_current_team = true
+	enabled = true
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "changeme"
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
@@ -2,300 +2,150 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelSlack() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelSlackCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelSlackUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelSlackRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelSlackDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
-
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"url": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
-			},
-			"channel": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"is_private_channel": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-				ForceNew: true,
-			},
-			"private_channel_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"show_section_runbook_links": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"show_section_event_details": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"show_section_user_defined_content": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"show_section_notification_chart": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"show_section_dashboard_links": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"show_section_alert_details": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"show_section_capturing_information": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-		}),
-	}
+const monitorSlackTemplateKey = "SLACK_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"
+
+// monitorSlackSections maps every "show_section_*" attribute to the template section it toggles.
+var monitorSlackSections = []struct {
+	attribute string
+	section   string
+}{
+	{"show_section_runbook_links", "MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS"},
+	{"show_section_event_details", "MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS"},
+	{"show_section_user_defined_content", "MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT"},
+	{"show_section_notification_chart", "MONITOR_ALERT_NOTIFICATION_CHART"},
+	{"show_section_dashboard_links", "MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS"},
+	{"show_section_alert_details", "MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS"},
+	{"show_section_capturing_information", "MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION"},
 }
 
-func resourceSysdigMonitorNotificationChannelSlackCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelSlackFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelSlackRead(ctx, d, meta)
+type monitorNotificationChannelSlackModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelSlackModel
 }
 
-func resourceSysdigMonitorNotificationChannelSlackRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
+type notificationChannelSlackModel struct {
+	URL                             types.String `tfsdk:"url"`
+	Channel                         types.String `tfsdk:"channel"`
+	IsPrivateChannel                types.Bool   `tfsdk:"is_private_channel"`
+	PrivateChannelURL               types.String `tfsdk:"private_channel_url"`
+	ShowSectionRunbookLinks         types.Bool   `tfsdk:"show_section_runbook_links"`
+	ShowSectionEventDetails         types.Bool   `tfsdk:"show_section_event_details"`
+	ShowSectionUserDefinedContent   types.Bool   `tfsdk:"show_section_user_defined_content"`
+	ShowSectionNotificationChart    types.Bool   `tfsdk:"show_section_notification_chart"`
+	ShowSectionDashboardLinks       types.Bool   `tfsdk:"show_section_dashboard_links"`
+	ShowSectionAlertDetails         types.Bool   `tfsdk:"show_section_alert_details"`
+	ShowSectionCapturingInformation types.Bool   `tfsdk:"show_section_capturing_information"`
+}
 
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+func newMonitorNotificationChannelSlackResource() resource.Resource {
+	attributes := map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			Required:  true,
+			Sensitive: true,
+		},
+		"channel": schema.StringAttribute{
+			Required: true,
+		},
+		"is_private_channel": schema.BoolAttribute{
+			Optional:      true,
+			Computed:      true,
+			Default:       booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+		},
+		"private_channel_url": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
 	}
-
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
+	for _, s := range monitorSlackSections {
+		attributes[s.attribute] = schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
 		}
-		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelSlackToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
+	return &monitorNotificationChannelResource{
+		typeName:    "slack",
+		channelType: notificationChannelTypeSlack,
+		attributes:  attributes,
+		newModel:    func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelSlackModel{} },
 	}
-
-	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelSlackUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
+func (m *notificationChannelSlackModel) sections() map[string]*types.Bool {
+	return map[string]*types.Bool{
+		"show_section_runbook_links":         &m.ShowSectionRunbookLinks,
+		"show_section_event_details":         &m.ShowSectionEventDetails,
+		"show_section_user_defined_content":  &m.ShowSectionUserDefinedContent,
+		"show_section_notification_chart":    &m.ShowSectionNotificationChart,
+		"show_section_dashboard_links":       &m.ShowSectionDashboardLinks,
+		"show_section_alert_details":         &m.ShowSectionAlertDetails,
+		"show_section_capturing_information": &m.ShowSectionCapturingInformation,
 	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelSlackFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, err = strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	resourceSysdigMonitorNotificationChannelSlackRead(ctx, d, meta)
-
-	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelSlackDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
+func (m *notificationChannelSlackModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.URL = m.URL.ValueString()
+	nc.Options.Channel = m.Channel.ValueString()
+	nc.Options.PrivateChannel = m.IsPrivateChannel.ValueBool()
+	nc.Options.PrivateChannelURL = m.PrivateChannelURL.ValueString()
 
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	sections := []v2.NotificationChannelTemplateConfigurationSection{
+		{
+			SectionName: "MONITOR_ALERT_NOTIFICATION_HEADER",
+			ShouldShow:  true,
+		},
 	}
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
+	values := m.sections()
+	for _, s := range monitorSlackSections {
+		sections = append(sections, v2.NotificationChannelTemplateConfigurationSection{
+			SectionName: s.section,
+			ShouldShow:  values[s.attribute].ValueBool(),
+		})
 	}
-
-	return nil
-}
-
-func monitorNotificationChannelSlackFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeSlack
-	nc.Options.URL = d.Get("url").(string)
-	nc.Options.Channel = d.Get("channel").(string)
-	nc.Options.PrivateChannel = d.Get("is_private_channel").(bool)
-	nc.Options.PrivateChannelURL = d.Get("private_channel_url").(string)
 	nc.Options.TemplateConfiguration = []v2.NotificationChannelTemplateConfiguration{
 		{
-			TemplateKey: "SLACK_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1",
-			TemplateConfigurationSections: []v2.NotificationChannelTemplateConfigurationSection{
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_HEADER",
-					ShouldShow:  true,
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS",
-					ShouldShow:  d.Get("show_section_runbook_links").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS",
-					ShouldShow:  d.Get("show_section_event_details").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT",
-					ShouldShow:  d.Get("show_section_user_defined_content").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_CHART",
-					ShouldShow:  d.Get("show_section_notification_chart").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS",
-					ShouldShow:  d.Get("show_section_dashboard_links").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS",
-					ShouldShow:  d.Get("show_section_alert_details").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION",
-					ShouldShow:  d.Get("show_section_capturing_information").(bool),
-				},
-			},
+			TemplateKey:                   monitorSlackTemplateKey,
+			TemplateConfigurationSections: sections,
 		},
 	}
-
-	return nc, err
+	return nil
 }
 
-func monitorNotificationChannelSlackToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("url", nc.Options.URL)
-	_ = d.Set("channel", nc.Options.Channel)
-	_ = d.Set("is_private_channel", nc.Options.PrivateChannel)
-	_ = d.Set("private_channel_url", nc.Options.PrivateChannelURL)
-
-	runbookLinks := true
-	eventDetails := true
-	userDefinedContent := true
-	notificationChart := true
-	dashboardLinks := true
-	alertDetails := true
-	capturingInformation := true
+func (m *notificationChannelSlackModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.URL = types.StringValue(nc.Options.URL)
+	m.Channel = types.StringValue(nc.Options.Channel)
+	m.IsPrivateChannel = types.BoolValue(nc.Options.PrivateChannel)
+	m.PrivateChannelURL = optionalString(nc.Options.PrivateChannelURL, m.PrivateChannelURL)
 
+	shown := map[string]bool{}
 	if len(nc.Options.TemplateConfiguration) == 1 {
 		for _, c := range nc.Options.TemplateConfiguration[0].TemplateConfigurationSections {
-			switch c.SectionName {
-			case "MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS":
-				runbookLinks = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS":
-				eventDetails = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT":
-				userDefinedContent = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_CHART":
-				notificationChart = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS":
-				dashboardLinks = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS":
-				alertDetails = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION":
-				capturingInformation = c.ShouldShow
-			}
+			shown[c.SectionName] = c.ShouldShow
 		}
 	}
 
-	_ = d.Set("show_section_runbook_links", runbookLinks)
-	_ = d.Set("show_section_event_details", eventDetails)
-	_ = d.Set("show_section_user_defined_content", userDefinedContent)
-	_ = d.Set("show_section_notification_chart", notificationChart)
-	_ = d.Set("show_section_dashboard_links", dashboardLinks)
-	_ = d.Set("show_section_alert_details", alertDetails)
-	_ = d.Set("show_section_capturing_information", capturingInformation)
-
-	return err
+	values := m.sections()
+	for _, s := range monitorSlackSections {
+		show, ok := shown[s.section]
+		if !ok {
+			show = true
+		}
+		*values[s.attribute] = types.BoolValue(show)
+	}
+	return nil
 }
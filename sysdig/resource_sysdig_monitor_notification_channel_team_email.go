@@ -2,165 +2,52 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelTeamEmail() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelTeamEmailCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelTeamEmailUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelTeamEmailRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelTeamEmailDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelTeamEmailModel struct {
+	monitorNotificationChannelModel
+	notificationChannelTeamEmailModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelTeamEmailModel struct {
+	TeamID            types.Int64 `tfsdk:"team_id"`
+	IncludeAdminUsers types.Bool  `tfsdk:"include_admin_users"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"team_id": {
-				Type:     schema.TypeInt,
+func newMonitorNotificationChannelTeamEmailResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "team_email",
+		channelType: notificationChannelTypeTeamEmail,
+		attributes: map[string]schema.Attribute{
+			"team_id": schema.Int64Attribute{
 				Required: true,
 			},
-			"include_admin_users": {
-				Type:     schema.TypeBool,
+			"include_admin_users": schema.BoolAttribute{
 				Optional: true,
-				Default:  false,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelTeamEmailCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelTeamEmailFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelTeamEmailRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelTeamEmailRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelTeamEmailToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelTeamEmailModel{} },
 	}
-
-	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelTeamEmailUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelTeamEmailFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelTeamEmailModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.TeamID = int(m.TeamID.ValueInt64())
+	includeAdminUsers := m.IncludeAdminUsers.ValueBool()
+	nc.Options.IncludeAdminUsers = &includeAdminUsers
 	return nil
 }
 
-func resourceSysdigMonitorNotificationChannelTeamEmailDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+func (m *notificationChannelTeamEmailModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.TeamID = types.Int64Value(int64(nc.Options.TeamID))
+	m.IncludeAdminUsers = optionalBoolPointer(nc.Options.IncludeAdminUsers, m.IncludeAdminUsers, false)
 	return nil
 }
-
-func monitorNotificationChannelTeamEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeTeamEmail
-	nc.Options.TeamID = d.Get("team_id").(int)
-	includeAdminUsers := d.Get("include_admin_users").(bool)
-	nc.Options.IncludeAdminUsers = &includeAdminUsers
-	return nc, err
-}
-
-func monitorNotificationChannelTeamEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
-
-	_ = d.Set("team_id", nc.Options.TeamID)
-	if nc.Options.IncludeAdminUsers != nil {
-		_ = d.Set("include_admin_users", *nc.Options.IncludeAdminUsers)
-	}
-
-	return err
-}
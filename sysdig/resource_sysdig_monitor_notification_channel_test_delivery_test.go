@@ -0,0 +1,53 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMonitorNotificationChannelTest(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelTestWithTriggers(rText, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sysdig_monitor_notification_channel_test.sample", "notification_channel_id", "sysdig_monitor_notification_channel_email.sample", "id"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_test.sample", "status", "SENT"),
+					resource.TestCheckResourceAttrSet("sysdig_monitor_notification_channel_test.sample", "tested_at"),
+				),
+			},
+			{
+				Config: monitorNotificationChannelTestWithTriggers(rText, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_test.sample", "triggers.secret_version", "v2"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_test.sample", "status", "SENT"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelTestWithTriggers(name, secretVersion string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "sample" {
+	name       = "Example Channel %s - Test"
+	recipients = ["foo@localhost.com"]
+}
+
+resource "sysdig_monitor_notification_channel_test" "sample" {
+	notification_channel_id = sysdig_monitor_notification_channel_email.sample.id
+
+	triggers = {
+		secret_version = "%s"
+	}
+}`, name, secretVersion)
+}
@@ -0,0 +1,51 @@
+package sysdig
+
+import (
+	"context"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type monitorNotificationChannelWebexModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelWebexModel
+}
+
+type notificationChannelWebexModel struct {
+	RoomID      types.String `tfsdk:"room_id"`
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+func newMonitorNotificationChannelWebexResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "webex",
+		channelType: notificationChannelTypeWebex,
+		attributes: map[string]schema.Attribute{
+			"room_id": schema.StringAttribute{
+				Required: true,
+			},
+			"access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelWebexModel{} },
+	}
+}
+
+func (m *notificationChannelWebexModel) toNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	nc.Options.RoomID = m.RoomID.ValueString()
+	nc.Options.AccessToken = m.AccessToken.ValueString()
+	return nil
+}
+
+func (m *notificationChannelWebexModel) fromNotificationChannel(_ context.Context, nc *v2.NotificationChannel) diag.Diagnostics {
+	m.RoomID = types.StringValue(nc.Options.RoomID)
+	m.AccessToken = types.StringValue(nc.Options.AccessToken)
+	return nil
+}
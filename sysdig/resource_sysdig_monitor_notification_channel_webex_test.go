@@ -0,0 +1,61 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMonitorNotificationChannelWebex(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelWebexWithName(rText()),
+			},
+			{
+				Config: monitorNotificationChannelWebexShareWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_webex.sample-webex",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelWebexWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webex" "sample-webex" {
+	name = "Example Channel %s - Webex"
+	enabled = true
+	room_id = "Y2lzY29zcGFyazovL3VzL1JPT00v"
+	access_token = "NjM0ZTUwZDAtYzRi"
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
+
+func monitorNotificationChannelWebexShareWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webex" "sample-webex" {
+	name = "Example Channel %s - Webex"
+	share_with_current_team = true
+	enabled = true
+	room_id = "Y2lzY29zcGFyazovL3VzL1JPT00v"
+	access_token = "NjM0ZTUwZDAtYzRi"
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
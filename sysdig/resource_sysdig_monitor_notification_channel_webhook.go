@@ -2,178 +2,75 @@ package sysdig
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceSysdigMonitorNotificationChannelWebhook() *schema.Resource {
-	timeout := 5 * time.Minute
-
-	return &schema.Resource{
-		CreateContext: resourceSysdigMonitorNotificationChannelWebhookCreate,
-		UpdateContext: resourceSysdigMonitorNotificationChannelWebhookUpdate,
-		ReadContext:   resourceSysdigMonitorNotificationChannelWebhookRead,
-		DeleteContext: resourceSysdigMonitorNotificationChannelWebhookDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type monitorNotificationChannelWebhookModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	notificationChannelWebhookModel
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(timeout),
-			Update: schema.DefaultTimeout(timeout),
-			Read:   schema.DefaultTimeout(timeout),
-			Delete: schema.DefaultTimeout(timeout),
-		},
+type notificationChannelWebhookModel struct {
+	URL                      types.String `tfsdk:"url"`
+	AdditionalHeaders        types.Map    `tfsdk:"additional_headers"`
+	AllowInsecureConnections types.Bool   `tfsdk:"allow_insecure_connections"`
+	CustomData               types.Map    `tfsdk:"custom_data"`
+}
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
-			"url": {
-				Type:     schema.TypeString,
+func newMonitorNotificationChannelWebhookResource() resource.Resource {
+	return &monitorNotificationChannelResource{
+		typeName:    "webhook",
+		channelType: notificationChannelTypeWebhook,
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
 				Required: true,
 			},
-			"additional_headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+			"additional_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
 			},
-			"allow_insecure_connections": {
-				Type:     schema.TypeBool,
+			"allow_insecure_connections": schema.BoolAttribute{
 				Optional: true,
-				Default:  false,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-			"custom_data": {
-				Type:     schema.TypeMap,
-				Optional: true,
+			"custom_data": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
 			},
-		}),
-	}
-}
-
-func resourceSysdigMonitorNotificationChannelWebhookCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	clients := meta.(SysdigClients)
-	client, err := getMonitorNotificationChannelClient(clients)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err := monitorNotificationChannelWebhookFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(strconv.Itoa(notificationChannel.ID))
-
-	return resourceSysdigMonitorNotificationChannelWebhookRead(ctx, d, meta)
-}
-
-func resourceSysdigMonitorNotificationChannelWebhookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-	nc, err := client.GetNotificationChannelByID(ctx, id)
-	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = monitorNotificationChannelWebhookToResourceData(&nc, d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func resourceSysdigMonitorNotificationChannelWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	teamID, err := client.CurrentTeamID(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc, err := monitorNotificationChannelWebhookFromResourceData(d, teamID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	nc.Version = d.Get("version").(int)
-	nc.ID, _ = strconv.Atoi(d.Id())
-
-	_, err = client.UpdateNotificationChannel(ctx, nc)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-func resourceSysdigMonitorNotificationChannelWebhookDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := getMonitorNotificationChannelClient(meta.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id, _ := strconv.Atoi(d.Id())
-
-	err = client.DeleteNotificationChannel(ctx, id)
-	if err != nil {
-		return diag.FromErr(err)
+		},
+		newModel: func() monitorNotificationChannelTypedModel { return &monitorNotificationChannelWebhookModel{} },
 	}
-
-	return nil
 }
 
-func monitorNotificationChannelWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return nc, err
-	}
-
-	nc.Type = notificationChannelTypeWebhook
-	nc.Options.URL = d.Get("url").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]any)
-	nc.Options.CustomData = d.Get("custom_data").(map[string]any)
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
+func (m *notificationChannelWebhookModel) toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	nc.Options.URL = m.URL.ValueString()
+	allowInsecureConnections := m.AllowInsecureConnections.ValueBool()
 	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return nc, err
-}
 
-func monitorNotificationChannelWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return err
-	}
+	var d diag.Diagnostics
+	nc.Options.AdditionalHeaders, d = stringMapFromModel(ctx, m.AdditionalHeaders)
+	diags.Append(d...)
+	nc.Options.CustomData, d = stringMapFromModel(ctx, m.CustomData)
+	diags.Append(d...)
+	return diags
+}
 
-	_ = d.Set("url", nc.Options.URL)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-	_ = d.Set("custom_data", nc.Options.CustomData)
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
+func (m *notificationChannelWebhookModel) fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	m.URL = types.StringValue(nc.Options.URL)
+	m.AllowInsecureConnections = optionalBoolPointer(nc.Options.AllowInsecureConnections, m.AllowInsecureConnections, false)
 
-	return err
+	var d diag.Diagnostics
+	m.AdditionalHeaders, d = optionalStringMap(ctx, nc.Options.AdditionalHeaders, m.AdditionalHeaders)
+	diags.Append(d...)
+	m.CustomData, d = optionalStringMap(ctx, nc.Options.CustomData, m.CustomData)
+	diags.Append(d...)
+	return diags
 }
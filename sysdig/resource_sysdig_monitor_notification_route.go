@@ -0,0 +1,362 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSysdigMonitorNotificationRoute() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	channels := createAlertV2NotificationChannelsSchema()
+	channels.Optional = false
+	channels.Required = true
+	channels.Elem.(*schema.Resource).Schema["custom_notification"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"subject": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"prepend": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"append": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	return teamScopedResource(&schema.Resource{
+		CreateContext: resourceSysdigMonitorNotificationRouteCreate,
+		UpdateContext: resourceSysdigMonitorNotificationRouteUpdate,
+		ReadContext:   resourceSysdigMonitorNotificationRouteRead,
+		DeleteContext: resourceSysdigMonitorNotificationRouteDelete,
+		CustomizeDiff: resourceSysdigMonitorNotificationRouteCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"severities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(AlertV2SeverityValues(), true),
+				},
+			},
+			"notification_channels": channels,
+			"alert_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	})
+}
+
+// notificationRoute routes the alerts matching its labels and severities to its notification channels.
+type notificationRoute struct {
+	labels     map[string]any
+	severities []string
+	channels   []v2.NotificationChannelConfigV2
+}
+
+func notificationRouteFromResourceData(d *schema.ResourceData) notificationRoute {
+	route := notificationRoute{labels: d.Get("labels").(map[string]any)}
+	for _, severity := range d.Get("severities").(*schema.Set).List() {
+		route.severities = append(route.severities, strings.ToLower(severity.(string)))
+	}
+	for _, channel := range d.Get("notification_channels").(*schema.Set).List() {
+		route.channels = append(route.channels, notificationRouteChannelConfig(channel.(map[string]any)))
+	}
+	return route
+}
+
+func notificationRouteChannelConfig(channelMap map[string]any) v2.NotificationChannelConfigV2 {
+	config := buildAlertV2NotificationChannelConfig(channelMap)
+	if customNotification, ok := channelMap["custom_notification"].([]any); ok && len(customNotification) > 0 && customNotification[0] != nil {
+		m := customNotification[0].(map[string]any)
+		config.OverrideOptions.CustomNotificationTemplate = &v2.CustomNotificationTemplateV2{
+			Subject:     m["subject"].(string),
+			PrependText: m["prepend"].(string),
+			AppendText:  m["append"].(string),
+		}
+	}
+	return config
+}
+
+// matches returns whether the alert has all the labels of the route and one of its severities.
+func (r notificationRoute) matches(alert v2.AlertV2Common) bool {
+	for label, value := range r.labels {
+		alertValue, ok := alert.Labels[label]
+		if !ok || fmt.Sprint(alertValue) != value.(string) {
+			return false
+		}
+	}
+	if len(r.severities) == 0 {
+		return true
+	}
+	severity := strings.ToLower(alert.Severity)
+	if severity == "none" {
+		severity = string(v2.AlertV2SeverityInfo)
+	}
+	return slices.Contains(r.severities, severity)
+}
+
+// isRouted returns whether the alert is notified to all the channels of the route, with the options of the route.
+func (r notificationRoute) isRouted(alert v2.AlertV2Common) bool {
+	for _, channel := range r.channels {
+		i := slices.IndexFunc(alert.NotificationChannelConfigList, func(c v2.NotificationChannelConfigV2) bool {
+			return c.ChannelID == channel.ChannelID
+		})
+		if i < 0 {
+			return false
+		}
+		alertChannel := alert.NotificationChannelConfigList[i]
+		if !maps.Equal(alertV2NotificationChannelConfigToMap(alertChannel), alertV2NotificationChannelConfigToMap(channel)) ||
+			customNotificationTemplateText(alertChannel.OverrideOptions.CustomNotificationTemplate) != customNotificationTemplateText(channel.OverrideOptions.CustomNotificationTemplate) {
+			return false
+		}
+	}
+	return true
+}
+
+func customNotificationTemplateText(template *v2.CustomNotificationTemplateV2) [3]string {
+	if template == nil {
+		return [3]string{}
+	}
+	return [3]string{template.Subject, template.PrependText, template.AppendText}
+}
+
+// routeChannels returns the notification channels of an alert without the ones previously routed,
+// and with the ones of the route when the alert matches it.
+func (r notificationRoute) routeChannels(channels []v2.NotificationChannelConfigV2, previousChannelIDs []int, matches bool) []v2.NotificationChannelConfigV2 {
+	routed := []v2.NotificationChannelConfigV2{}
+	for _, channel := range channels {
+		if slices.Contains(previousChannelIDs, channel.ChannelID) || slices.ContainsFunc(r.channels, func(c v2.NotificationChannelConfigV2) bool {
+			return c.ChannelID == channel.ChannelID
+		}) {
+			continue
+		}
+		routed = append(routed, channel)
+	}
+	if matches {
+		routed = append(routed, r.channels...)
+	}
+	return routed
+}
+
+func (r notificationRoute) channelIDs() []int {
+	var ids []int
+	for _, channel := range r.channels {
+		ids = append(ids, channel.ChannelID)
+	}
+	return ids
+}
+
+// apply routes the matching alerts to the channels of the route, and removes the channels previously
+// routed from the alerts which were routed and don't match anymore.
+func (r notificationRoute) apply(ctx context.Context, client v2.AlertV2Interface, previousAlertIDs []int, previousChannelIDs []int) error {
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		matches := r.matches(alert)
+		if !matches && !slices.Contains(previousAlertIDs, alert.ID) {
+			continue
+		}
+		if matches && r.isRouted(alert) && !slices.ContainsFunc(alert.NotificationChannelConfigList, func(c v2.NotificationChannelConfigV2) bool {
+			return slices.Contains(previousChannelIDs, c.ChannelID) && !slices.Contains(r.channelIDs(), c.ChannelID)
+		}) {
+			continue
+		}
+
+		fullAlert, err := client.GetAlertV2ByID(ctx, alert.ID)
+		if err != nil {
+			if v2.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		fullAlert.NotificationChannelConfigList = r.routeChannels(fullAlert.NotificationChannelConfigList, previousChannelIDs, matches)
+		if _, err := client.UpdateAlertV2(ctx, fullAlert); err != nil {
+			return fmt.Errorf("error routing alert %d: %w", alert.ID, err)
+		}
+	}
+	return nil
+}
+
+func notificationRouteChannelIDs(channels *schema.Set) []int {
+	var ids []int
+	for _, channel := range channels.List() {
+		ids = append(ids, channel.(map[string]any)["id"].(int))
+	}
+	return ids
+}
+
+func intSetToSlice(set *schema.Set) []int {
+	var ids []int
+	for _, i := range set.List() {
+		ids = append(ids, i.(int))
+	}
+	return ids
+}
+
+func resourceSysdigMonitorNotificationRouteCreate(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2Client(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = notificationRouteFromResourceData(d).apply(ctx, client, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return resourceSysdigMonitorNotificationRouteRead(ctx, d, i)
+}
+
+func resourceSysdigMonitorNotificationRouteUpdate(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2Client(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previousAlertIDs, _ := d.GetChange("alert_ids")
+	previousChannels, _ := d.GetChange("notification_channels")
+	err = notificationRouteFromResourceData(d).apply(ctx, client, intSetToSlice(previousAlertIDs.(*schema.Set)), notificationRouteChannelIDs(previousChannels.(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorNotificationRouteRead(ctx, d, i)
+}
+
+func resourceSysdigMonitorNotificationRouteRead(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2Client(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the alerts which don't match anymore are kept until the update removes the channels of the route from them,
+	// and the matching ones are kept only if they are still notified as configured in the route.
+	route := notificationRouteFromResourceData(d)
+	previousAlertIDs := intSetToSlice(d.Get("alert_ids").(*schema.Set))
+	alertIDs := []any{}
+	for _, alert := range alerts {
+		if route.matches(alert) {
+			if route.isRouted(alert) {
+				alertIDs = append(alertIDs, alert.ID)
+			}
+		} else if slices.Contains(previousAlertIDs, alert.ID) {
+			alertIDs = append(alertIDs, alert.ID)
+		}
+	}
+	_ = d.Set("alert_ids", alertIDs)
+
+	return nil
+}
+
+func resourceSysdigMonitorNotificationRouteDelete(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+	client, err := getAlertV2Client(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	channelIDs := notificationRouteChannelIDs(d.Get("notification_channels").(*schema.Set))
+	for _, alertID := range intSetToSlice(d.Get("alert_ids").(*schema.Set)) {
+		alert, err := client.GetAlertV2ByID(ctx, alertID)
+		if err != nil {
+			if v2.IsNotFound(err) {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		alert.NotificationChannelConfigList = notificationRoute{}.routeChannels(alert.NotificationChannelConfigList, channelIDs, false)
+		if _, err := client.UpdateAlertV2(ctx, alert); err != nil {
+			return diag.FromErr(fmt.Errorf("error removing the route from alert %d: %w", alertID, err))
+		}
+	}
+
+	return nil
+}
+
+// resourceSysdigMonitorNotificationRouteCustomizeDiff plans the update of the route when the alerts
+// matching it changed since the last apply.
+func resourceSysdigMonitorNotificationRouteCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, i any) error {
+	// the alerts created in the same apply as the route can't be known yet
+	if diff.Id() == "" || !diff.NewValueKnown("labels") || !diff.NewValueKnown("severities") {
+		return diff.SetNewComputed("alert_ids")
+	}
+
+	client, err := getAlertV2Client(i.(SysdigClients))
+	if err != nil {
+		return err
+	}
+
+	ctx = v2.WithTeamOverride(ctx, v2.TeamOverride{
+		ID:   diff.Get(SchemaTeamIDKey).(int),
+		Name: diff.Get(SchemaTeamNameKey).(string),
+	})
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return err
+	}
+
+	route := notificationRoute{labels: diff.Get("labels").(map[string]any)}
+	for _, severity := range diff.Get("severities").(*schema.Set).List() {
+		route.severities = append(route.severities, strings.ToLower(severity.(string)))
+	}
+	alertIDs := []any{}
+	for _, alert := range alerts {
+		if route.matches(alert) {
+			alertIDs = append(alertIDs, alert.ID)
+		}
+	}
+
+	if !schema.NewSet(schema.HashInt, alertIDs).Equal(diff.Get("alert_ids")) {
+		return diff.SetNew("alert_ids", alertIDs)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNotificationRoute(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: notificationRoute(rText, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_route.sample", "alert_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("sysdig_monitor_notification_route.sample", "alert_ids.*", "sysdig_monitor_alert_v2_metric.routed", "id"),
+				),
+			},
+			{
+				Config: notificationRoute(rText, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_route.sample", "alert_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func notificationRoute(name string, renotifyEveryMinutes int) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "sample" {
+	name = "%[1]s"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_alert_v2_metric" "routed" {
+	name = "TERRAFORM TEST - ROUTED %[1]s"
+	severity = "high"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	range_seconds = 600
+	labels = {
+		route = "%[1]s"
+	}
+	lifecycle {
+		ignore_changes = [notification_channels]
+	}
+}
+
+resource "sysdig_monitor_alert_v2_metric" "not_routed" {
+	name = "TERRAFORM TEST - NOT ROUTED %[1]s"
+	severity = "low"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	range_seconds = 600
+	labels = {
+		route = "%[1]s"
+	}
+	lifecycle {
+		ignore_changes = [notification_channels]
+	}
+}
+
+resource "sysdig_monitor_notification_route" "sample" {
+	name = "%[1]s"
+	labels = {
+		route = "%[1]s"
+	}
+	severities = ["high"]
+
+	notification_channels {
+		id = sysdig_monitor_notification_channel_email.sample.id
+		renotify_every_minutes = %[2]d
+		custom_notification {
+			subject = "routed by %[1]s"
+		}
+	}
+
+	depends_on = [sysdig_monitor_alert_v2_metric.routed, sysdig_monitor_alert_v2_metric.not_routed]
+}
+`, name, renotifyEveryMinutes)
+}
@@ -0,0 +1,108 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestNotificationRoute(t *testing.T) {
+	ctx := context.Background()
+	provider, client := newMockAPIMonitorProvider(t)
+
+	var channelIDs []int
+	for _, name := range []string{"On call", "Audit"} {
+		channel, err := client.CreateNotificationChannel(ctx, v2.NotificationChannel{
+			Type:    notificationChannelTypeEmail,
+			Name:    name,
+			Enabled: true,
+			Options: v2.NotificationChannelOptions{EmailRecipients: []string{"oncall@example.com"}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		channelIDs = append(channelIDs, channel.ID)
+	}
+	var alertIDs []int
+	for _, alert := range []struct {
+		severity string
+		team     string
+	}{{"high", "payments"}, {"low", "payments"}, {"high", "search"}} {
+		created, err := client.CreateAlertV2Prometheus(ctx, v2.AlertV2Prometheus{
+			AlertV2Common: v2.AlertV2Common{
+				Name:     "Disk full",
+				Type:     string(v2.AlertV2TypePrometheus),
+				Severity: alert.severity,
+				Enabled:  true,
+				Labels:   map[string]any{"team": alert.team},
+				NotificationChannelConfigList: []v2.NotificationChannelConfigV2{
+					{ChannelID: channelIDs[1], OverrideOptions: v2.NotificationChannelOptionsV2{NotifyOnResolve: true, Thresholds: []string{"MAIN"}}},
+				},
+			},
+			Config: v2.AlertV2ConfigPrometheus{Query: "up == 0", Duration: 300},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		alertIDs = append(alertIDs, created.ID)
+	}
+
+	resource := resourceSysdigMonitorNotificationRoute()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{
+		"name":       "payments",
+		"labels":     map[string]any{"team": "payments"},
+		"severities": []any{"high"},
+		"notification_channels": []any{map[string]any{
+			"id":                     channelIDs[0],
+			"renotify_every_minutes": 30,
+			"custom_notification":    []any{map[string]any{"subject": "payments are down"}},
+		}},
+	})
+	if diags := resource.CreateContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if ids := d.Get("alert_ids").(*schema.Set); ids.Len() != 1 || !ids.Contains(alertIDs[0]) {
+		t.Fatalf("expected only alert %d to be routed, got %v", alertIDs[0], ids.List())
+	}
+
+	routed, err := client.GetAlertV2ByID(ctx, alertIDs[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(routed.NotificationChannelConfigList) != 2 {
+		t.Fatalf("expected the alert to keep its channel and get the one of the route, got %+v", routed.NotificationChannelConfigList)
+	}
+	channel := routed.NotificationChannelConfigList[1]
+	if channel.ChannelID != channelIDs[0] || *channel.OverrideOptions.ReNotifyEverySec != 1800 ||
+		channel.OverrideOptions.CustomNotificationTemplate.Subject != "payments are down" {
+		t.Errorf("unexpected routed channel %+v", channel)
+	}
+	if routed.Config == nil || string(routed.Config) == "{}" {
+		t.Errorf("expected the configuration of the alert to be kept, got %s", routed.Config)
+	}
+	for _, id := range alertIDs[1:] {
+		alert, err := client.GetAlertV2ByID(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(alert.NotificationChannelConfigList) != 1 {
+			t.Errorf("expected alert %d not to be routed, got %+v", id, alert.NotificationChannelConfigList)
+		}
+	}
+
+	if diags := resource.DeleteContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	unrouted, err := client.GetAlertV2ByID(ctx, alertIDs[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(unrouted.NotificationChannelConfigList) != 1 || unrouted.NotificationChannelConfigList[0].ChannelID != channelIDs[1] {
+		t.Errorf("expected the channel of the route to be removed, got %+v", unrouted.NotificationChannelConfigList)
+	}
+}
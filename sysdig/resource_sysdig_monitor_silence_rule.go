@@ -15,7 +15,7 @@ import (
 func resourceSysdigMonitorSilenceRule() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigMonitorSilenceRuleCreate,
 		UpdateContext: resourceSysdigMonitorSilenceRuleUpdate,
 		ReadContext:   resourceSysdigMonitorSilenceRuleRead,
@@ -73,7 +73,7 @@ func resourceSysdigMonitorSilenceRule() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	})
 }
 
 func getMonitorSilenceRuleClient(c SysdigClients) (v2.SilenceRuleInterface, error) {
@@ -128,7 +128,7 @@ func resourceSysdigMonitorSilenceRuleRead(ctx context.Context, d *schema.Resourc
 
 	silenceRule, err := client.GetSilenceRule(ctx, id)
 	if err != nil {
-		if err == v2.ErrSilenceRuleNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
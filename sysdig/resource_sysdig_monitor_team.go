@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -184,9 +183,9 @@ func resourceSysdigMonitorTeamRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	t, statusCode, err := client.GetTeamByID(ctx, id)
+	t, err := client.GetTeamByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,321 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// notificationChannelOptionsModel is implemented by the models of the attributes specific
+// to each channel type, shared by sysdig_notification_channel and the typed resources.
+type notificationChannelOptionsModel interface {
+	toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) diag.Diagnostics
+	fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) diag.Diagnostics
+}
+
+// notificationChannelType is a channel type of sysdig_notification_channel, configured in the
+// block named after its sysdig_monitor_notification_channel_* resource, with the same attributes.
+type notificationChannelType struct {
+	*monitorNotificationChannelResource
+	newOptions func() notificationChannelOptionsModel
+}
+
+func notificationChannelTypes() []notificationChannelType {
+	constructors := []struct {
+		newResource func() resource.Resource
+		newOptions  func() notificationChannelOptionsModel
+	}{
+		{newMonitorNotificationChannelCustomWebhookResource, func() notificationChannelOptionsModel { return &notificationChannelCustomWebhookModel{} }},
+		{newMonitorNotificationChannelEmailResource, func() notificationChannelOptionsModel { return &notificationChannelEmailModel{} }},
+		{newMonitorNotificationChannelGoogleChatResource, func() notificationChannelOptionsModel { return &notificationChannelGoogleChatModel{} }},
+		{newMonitorNotificationChannelIBMEventNotificationResource, func() notificationChannelOptionsModel { return &notificationChannelIBMEventNotificationModel{} }},
+		{newMonitorNotificationChannelJiraResource, func() notificationChannelOptionsModel { return &notificationChannelJiraModel{} }},
+		{newMonitorNotificationChannelMSTeamsResource, func() notificationChannelOptionsModel { return &notificationChannelMSTeamsModel{} }},
+		{newMonitorNotificationChannelOpsGenieResource, func() notificationChannelOptionsModel { return &notificationChannelOpsGenieModel{} }},
+		{newMonitorNotificationChannelPagerdutyResource, func() notificationChannelOptionsModel { return &notificationChannelPagerdutyModel{} }},
+		{newMonitorNotificationChannelPrometheusAlertManagerResource, func() notificationChannelOptionsModel { return &notificationChannelPrometheusAlertManagerModel{} }},
+		{newMonitorNotificationChannelServiceNowResource, func() notificationChannelOptionsModel { return &notificationChannelServiceNowModel{} }},
+		{newMonitorNotificationChannelSlackResource, func() notificationChannelOptionsModel { return &notificationChannelSlackModel{} }},
+		{newMonitorNotificationChannelSNSResource, func() notificationChannelOptionsModel { return &notificationChannelSNSModel{} }},
+		{newMonitorNotificationChannelTeamEmailResource, func() notificationChannelOptionsModel { return &notificationChannelTeamEmailModel{} }},
+		{newMonitorNotificationChannelVictorOpsResource, func() notificationChannelOptionsModel { return &notificationChannelVictorOpsModel{} }},
+		{newMonitorNotificationChannelWebexResource, func() notificationChannelOptionsModel { return &notificationChannelWebexModel{} }},
+		{newMonitorNotificationChannelWebhookResource, func() notificationChannelOptionsModel { return &notificationChannelWebhookModel{} }},
+	}
+
+	channelTypes := make([]notificationChannelType, 0, len(constructors))
+	for _, t := range constructors {
+		channelTypes = append(channelTypes, notificationChannelType{
+			monitorNotificationChannelResource: t.newResource().(*monitorNotificationChannelResource),
+			newOptions:                         t.newOptions,
+		})
+	}
+	return channelTypes
+}
+
+func (t notificationChannelType) attributeTypes() map[string]attr.Type {
+	attributeTypes := make(map[string]attr.Type, len(t.attributes))
+	for name, attribute := range t.attributes {
+		attributeTypes[name] = attribute.GetType()
+	}
+	return attributeTypes
+}
+
+// block returns the attributes of the channel type as a block. The framework validates the
+// attributes of the blocks which are not configured too, so the required ones are checked
+// by the block instead.
+func (t notificationChannelType) block() schema.SingleNestedBlock {
+	var required []string
+	attributes := make(map[string]schema.Attribute, len(t.attributes))
+	for name, attribute := range t.attributes {
+		if attribute.IsRequired() {
+			required = append(required, name)
+			attribute = optionalAttribute(attribute)
+		}
+		attributes[name] = attribute
+	}
+	slices.Sort(required)
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+		Validators: []validator.Object{requiredAttributesValidator(required)},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(
+				func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				},
+				"The type of a notification channel can't be changed.",
+				"The type of a notification channel can't be changed.",
+			),
+		},
+	}
+}
+
+func optionalAttribute(attribute schema.Attribute) schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		a.Required, a.Optional = false, true
+		return a
+	case schema.BoolAttribute:
+		a.Required, a.Optional = false, true
+		return a
+	case schema.Int64Attribute:
+		a.Required, a.Optional = false, true
+		return a
+	case schema.SetAttribute:
+		a.Required, a.Optional = false, true
+		return a
+	case schema.MapAttribute:
+		a.Required, a.Optional = false, true
+		return a
+	case schema.ListAttribute:
+		a.Required, a.Optional = false, true
+		return a
+	}
+	panic(fmt.Sprintf("unsupported attribute type %T", attribute))
+}
+
+type requiredAttributesValidator []string
+
+func (v requiredAttributesValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("the attributes %s must be configured", strings.Join(v, ", "))
+}
+
+func (v requiredAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requiredAttributesValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attributes := req.ConfigValue.Attributes()
+	for _, name := range v {
+		if attributes[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName(name),
+				"Missing Configuration for Required Attribute",
+				fmt.Sprintf("Must set a configuration value for the %s attribute as the provider has marked it as required.", req.Path.AtName(name)),
+			)
+		}
+	}
+}
+
+// notificationChannelModel is the model of sysdig_notification_channel, with one object per
+// channel type of which only the one of the channel is not null.
+type notificationChannelModel struct {
+	monitorNotificationChannelModel
+	teamOverrideModel
+	Product                types.String `tfsdk:"product"`
+	CustomWebhook          types.Object `tfsdk:"custom_webhook"`
+	Email                  types.Object `tfsdk:"email"`
+	GoogleChat             types.Object `tfsdk:"google_chat"`
+	IBMEventNotification   types.Object `tfsdk:"ibm_event_notification"`
+	Jira                   types.Object `tfsdk:"jira"`
+	MSTeams                types.Object `tfsdk:"msteams"`
+	OpsGenie               types.Object `tfsdk:"opsgenie"`
+	Pagerduty              types.Object `tfsdk:"pagerduty"`
+	PrometheusAlertManager types.Object `tfsdk:"prometheus_alert_manager"`
+	ServiceNow             types.Object `tfsdk:"servicenow"`
+	Slack                  types.Object `tfsdk:"slack"`
+	SNS                    types.Object `tfsdk:"sns"`
+	TeamEmail              types.Object `tfsdk:"team_email"`
+	VictorOps              types.Object `tfsdk:"victorops"`
+	Webex                  types.Object `tfsdk:"webex"`
+	Webhook                types.Object `tfsdk:"webhook"`
+}
+
+func (m *notificationChannelModel) product() string {
+	return m.Product.ValueString()
+}
+
+func (m *notificationChannelModel) blocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"custom_webhook":           &m.CustomWebhook,
+		"email":                    &m.Email,
+		"google_chat":              &m.GoogleChat,
+		"ibm_event_notification":   &m.IBMEventNotification,
+		"jira":                     &m.Jira,
+		"msteams":                  &m.MSTeams,
+		"opsgenie":                 &m.OpsGenie,
+		"pagerduty":                &m.Pagerduty,
+		"prometheus_alert_manager": &m.PrometheusAlertManager,
+		"servicenow":               &m.ServiceNow,
+		"slack":                    &m.Slack,
+		"sns":                      &m.SNS,
+		"team_email":               &m.TeamEmail,
+		"victorops":                &m.VictorOps,
+		"webex":                    &m.Webex,
+		"webhook":                  &m.Webhook,
+	}
+}
+
+func (m *notificationChannelModel) toNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	blocks := m.blocks()
+	for _, t := range notificationChannelTypes() {
+		block := blocks[t.typeName]
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+
+		options := t.newOptions()
+		diags.Append(block.As(ctx, options, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+		nc.Type = t.channelType
+		diags.Append(options.toNotificationChannel(ctx, nc)...)
+		return diags
+	}
+
+	diags.AddError("Missing notification channel type", "One of the blocks of the notification channel types must be configured.")
+	return diags
+}
+
+func (m *notificationChannelModel) fromNotificationChannel(ctx context.Context, nc *v2.NotificationChannel) (diags diag.Diagnostics) {
+	found := false
+	blocks := m.blocks()
+	for _, t := range notificationChannelTypes() {
+		block := blocks[t.typeName]
+		if t.channelType != nc.Type {
+			*block = types.ObjectNull(t.attributeTypes())
+			continue
+		}
+
+		// the prior values let the options keep the unset optional attributes null
+		options := t.newOptions()
+		if !block.IsNull() && !block.IsUnknown() {
+			diags.Append(block.As(ctx, options, basetypes.ObjectAsOptions{})...)
+		}
+		diags.Append(options.fromNotificationChannel(ctx, nc)...)
+		if diags.HasError() {
+			return diags
+		}
+		var d diag.Diagnostics
+		*block, d = types.ObjectValueFrom(ctx, t.attributeTypes(), options)
+		diags.Append(d...)
+		found = true
+	}
+
+	if !found {
+		diags.AddError("Unsupported notification channel type", fmt.Sprintf("The notification channel %d has the type %s, which is not supported.", nc.ID, nc.Type))
+	}
+	return diags
+}
+
+// notificationChannelResource is sysdig_notification_channel, managing the channels of every
+// type through the API of either product. It shares the lifecycle of the typed resources.
+type notificationChannelResource struct {
+	*monitorNotificationChannelResource
+}
+
+var (
+	_ resource.ResourceWithConfigure        = &notificationChannelResource{}
+	_ resource.ResourceWithImportState      = &notificationChannelResource{}
+	_ resource.ResourceWithConfigValidators = &notificationChannelResource{}
+)
+
+func newNotificationChannelResource() resource.Resource {
+	return &notificationChannelResource{
+		monitorNotificationChannelResource: &monitorNotificationChannelResource{
+			newModel: func() monitorNotificationChannelTypedModel { return &notificationChannelModel{} },
+		},
+	}
+}
+
+func (r *notificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *notificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.monitorNotificationChannelResource.Schema(ctx, req, resp)
+
+	resp.Schema.Attributes["product"] = schema.StringAttribute{
+		Required:      true,
+		Validators:    []validator.String{stringvalidator.OneOf("monitor", "secure")},
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	for _, t := range notificationChannelTypes() {
+		resp.Schema.Blocks[t.typeName] = t.block()
+	}
+}
+
+func (r *notificationChannelResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	var blocks path.Expressions
+	for _, t := range notificationChannelTypes() {
+		blocks = append(blocks, path.MatchRoot(t.typeName))
+	}
+	return []resource.ConfigValidator{resourcevalidator.ExactlyOneOf(blocks...)}
+}
+
+// ImportState accepts the ID of a Monitor channel, or <product>/<ID> for the channels
+// of the other product.
+func (r *notificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	product, id, found := strings.Cut(req.ID, "/")
+	if !found {
+		product, id = "monitor", req.ID
+	}
+	if product != "monitor" && product != "secure" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected <ID> or <product>/<ID> with product monitor or secure, got %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product"), product)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
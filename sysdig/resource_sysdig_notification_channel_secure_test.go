@@ -0,0 +1,45 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common || tf_acc_onprem_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNotificationChannelSecure(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: notificationChannelSecureGoogleChat(rText),
+				Check:  resource.TestCheckResourceAttr("sysdig_notification_channel.sample", "google_chat.url", "https://chat.googleapis.com/v1/spaces/AAAA/messages"),
+			},
+			{
+				ResourceName:            "sysdig_notification_channel.sample",
+				ImportState:             true,
+				ImportStateIdPrefix:     "secure/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func notificationChannelSecureGoogleChat(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_notification_channel" "sample" {
+	product = "secure"
+	name    = "Example Channel %s - Unified"
+
+	google_chat {
+		url = "https://chat.googleapis.com/v1/spaces/AAAA/messages"
+	}
+}`, name)
+}
@@ -0,0 +1,66 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common || tf_acc_onprem_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNotificationChannelMonitor(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 sysdigOrIBMMonitorPreCheck(t),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: notificationChannelEmail(rText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_notification_channel.sample", "email.recipients.#", "1"),
+					resource.TestCheckNoResourceAttr("sysdig_notification_channel.sample", "webhook.url"),
+				),
+			},
+			{
+				Config: notificationChannelWebhook(rText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_notification_channel.sample", "webhook.url", "https://example.com/"),
+					resource.TestCheckNoResourceAttr("sysdig_notification_channel.sample", "email.recipients.#"),
+				),
+			},
+			{
+				ResourceName:            "sysdig_notification_channel.sample",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func notificationChannelEmail(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_notification_channel" "sample" {
+	product = "monitor"
+	name    = "Example Channel %s - Unified"
+
+	email {
+		recipients = ["foo@localhost.com"]
+	}
+}`, name)
+}
+
+func notificationChannelWebhook(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_notification_channel" "sample" {
+	product = "monitor"
+	name    = "Example Channel %s - Unified"
+
+	webhook {
+		url = "https://example.com/"
+	}
+}`, name)
+}
@@ -0,0 +1,214 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	notificationChannelTestStatusSent   = "SENT"
+	notificationChannelTestStatusFailed = "FAILED"
+)
+
+// notificationChannelTestModel holds the attributes of the
+// sysdig_*_notification_channel_test resources.
+type notificationChannelTestModel struct {
+	ID                    types.String `tfsdk:"id"`
+	NotificationChannelID types.Int64  `tfsdk:"notification_channel_id"`
+	Triggers              types.Map    `tfsdk:"triggers"`
+	FailOnError           types.Bool   `tfsdk:"fail_on_error"`
+	Status                types.String `tfsdk:"status"`
+	ErrorMessage          types.String `tfsdk:"error_message"`
+	TestedAt              types.String `tfsdk:"tested_at"`
+}
+
+func (m *notificationChannelTestModel) test() *notificationChannelTestModel {
+	return m
+}
+
+type monitorNotificationChannelTestModel struct {
+	notificationChannelTestModel
+	teamOverrideModel
+}
+
+type notificationChannelTestTypedModel interface {
+	test() *notificationChannelTestModel
+}
+
+// notificationChannelTestResource sends a test notification to a channel when it is created, by
+// saving it with sendTestNotification set, and keeps whether it was sent. Like a null_resource, it is replaced
+// to send a new test notification when its triggers change.
+type notificationChannelTestResource struct {
+	clients SysdigClients
+
+	product   string
+	getClient func(SysdigClients) (v2.NotificationChannelInterface, error)
+	newModel  func() notificationChannelTestTypedModel
+}
+
+var _ resource.ResourceWithConfigure = &notificationChannelTestResource{}
+
+func newMonitorNotificationChannelTestResource() resource.Resource {
+	return &notificationChannelTestResource{
+		product:   "monitor",
+		getClient: getMonitorNotificationChannelClient,
+		newModel:  func() notificationChannelTestTypedModel { return &monitorNotificationChannelTestModel{} },
+	}
+}
+
+func newSecureNotificationChannelTestResource() resource.Resource {
+	return &notificationChannelTestResource{
+		product:   "secure",
+		getClient: getSecureNotificationChannelClient,
+		newModel:  func() notificationChannelTestTypedModel { return &notificationChannelTestModel{} },
+	}
+}
+
+func (r *notificationChannelTestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_notification_channel_test", req.ProviderTypeName, r.product)
+}
+
+func (r *notificationChannelTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"notification_channel_id": schema.Int64Attribute{
+			Required:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+		},
+		"triggers": schema.MapAttribute{
+			ElementType:   types.StringType,
+			Optional:      true,
+			PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+		},
+		"fail_on_error": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"status": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"error_message": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"tested_at": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+	}
+	if _, ok := r.newModel().(teamScopedModel); ok {
+		maps.Copy(attributes, teamOverrideAttributes())
+	}
+
+	resp.Schema = schema.Schema{Attributes: attributes}
+}
+
+func (r *notificationChannelTestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.clients = configureFrameworkResource(req, resp)
+}
+
+func (r *notificationChannelTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(contextWithTeamOverride(ctx, model), monitorNotificationChannelTimeout)
+	defer cancel()
+
+	client, err := r.getClient(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError("Error testing notification channel", err.Error())
+		return
+	}
+
+	m := model.test()
+	id := int(m.NotificationChannelID.ValueInt64())
+	channel, err := client.GetNotificationChannelByID(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error testing notification channel", err.Error())
+		return
+	}
+
+	// the API sends a test notification when a channel is saved with sendTestNotification, it
+	// doesn't report whether it was delivered: a rejected update is the only failure known
+	testedAt := time.Now()
+	m.Status = types.StringValue(notificationChannelTestStatusSent)
+	m.ErrorMessage = types.StringValue("")
+	channel.Options.SendTestNotification = true
+	if _, err := client.UpdateNotificationChannel(ctx, channel); err != nil {
+		if m.FailOnError.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("notification_channel_id"),
+				"Test notification not sent",
+				fmt.Sprintf("the test notification of the channel %d was not sent: %s", id, err),
+			)
+			return
+		}
+		m.Status = types.StringValue(notificationChannelTestStatusFailed)
+		m.ErrorMessage = types.StringValue(err.Error())
+	}
+
+	m.ID = types.StringValue(fmt.Sprintf("%d-%d", id, testedAt.UnixMilli()))
+	m.TestedAt = types.StringValue(testedAt.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read only checks the channel still exists: the result of the test is not stored by the
+// backend, so it is kept as it was when the resource was created.
+func (r *notificationChannelTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(contextWithTeamOverride(ctx, model), monitorNotificationChannelTimeout)
+	defer cancel()
+
+	client, err := r.getClient(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading notification channel", err.Error())
+		return
+	}
+
+	_, err = client.GetNotificationChannelByID(ctx, int(model.test().NotificationChannelID.ValueInt64()))
+	if err != nil {
+		if v2.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading notification channel", err.Error())
+	}
+}
+
+// Update only happens when fail_on_error changes, which doesn't send a new test notification.
+func (r *notificationChannelTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	model := r.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *notificationChannelTestResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
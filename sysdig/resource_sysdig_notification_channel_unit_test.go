@@ -0,0 +1,96 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNotificationChannel(t *testing.T) {
+	ctx := context.Background()
+	provider, client := newMockAPISecureProvider(t)
+
+	r := newNotificationChannelResource().(*notificationChannelResource)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: provider.Meta()}, &resource.ConfigureResponse{})
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("invalid schema: %v", diags)
+	}
+	s := schemaResp.Schema
+
+	model := &notificationChannelModel{
+		monitorNotificationChannelModel: monitorNotificationChannelModel{
+			ID:                   types.StringUnknown(),
+			Name:                 types.StringValue("security events"),
+			Enabled:              types.BoolValue(true),
+			ShareWithCurrentTeam: types.BoolValue(false),
+			NotifyWhenOk:         types.BoolValue(false),
+			NotifyWhenResolved:   types.BoolValue(false),
+			Version:              types.Int64Unknown(),
+			SendTestNotification: types.BoolValue(false),
+			Timeouts:             timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType})},
+		},
+		Product: types.StringValue("secure"),
+	}
+	for name, block := range model.blocks() {
+		*block = types.ObjectNull(s.Blocks[name].Type().(types.ObjectType).AttrTypes)
+	}
+	model.Webex = types.ObjectValueMust(model.Webex.AttributeTypes(ctx), map[string]attr.Value{
+		"room_id":      types.StringValue("Y2lzY29zcGFyazovL3VzL1JPT00v"),
+		"access_token": types.StringValue("NjM0ZTUwZDAt"),
+	})
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	created := &notificationChannelModel{}
+	createResp.State.Get(ctx, created)
+	id, _ := strconv.Atoi(created.ID.ValueString())
+	nc, err := client.GetNotificationChannelByID(ctx, id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nc.Type != notificationChannelTypeWebex || nc.Options.RoomID != "Y2lzY29zcGFyazovL3VzL1JPT00v" || nc.Options.AccessToken != "NjM0ZTUwZDAt" {
+		t.Errorf("unexpected channel %+v", nc)
+	}
+
+	importResp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "secure/" + created.ID.ValueString()}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", importResp.Diagnostics)
+	}
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	imported := &notificationChannelModel{}
+	readResp.State.Get(ctx, imported)
+	if !imported.Webex.Equal(created.Webex) || !imported.Slack.IsNull() || imported.Name.ValueString() != "security events" {
+		t.Errorf("expected the imported channel to be the created one, got %+v", imported)
+	}
+	var product string
+	readResp.State.GetAttribute(ctx, path.Root("product"), &product)
+	if product != "secure" {
+		t.Errorf("expected the product to be imported, got %s", product)
+	}
+}
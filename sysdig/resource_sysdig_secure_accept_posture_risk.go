@@ -132,9 +132,9 @@ func resourceSysdigSecureAcceptPostureControlCreate(ctx context.Context, d *sche
 		return diag.Errorf("Error creating accept risk. error status: %s err: %s", "ExpiresAt must be in the future", fmt.Errorf("ExpiresAt must be in the future"))
 	}
 	req.ExpiresAt = strconv.FormatInt(endTime, 10)
-	acceptance, errStatus, err := client.SaveAcceptPostureRisk(ctx, req)
+	acceptance, err := client.SaveAcceptPostureRisk(ctx, req)
 	if err != nil {
-		return diag.Errorf("Error creating accept risk. error status: %s err: %s", errStatus, err)
+		return diag.Errorf("Error creating accept risk: %s", err)
 	}
 	d.SetId(acceptance.Data.AcceptanceID)
 	resourceSysdigSecureAcceptPostureControlRead(ctx, d, meta)
@@ -185,9 +185,9 @@ func resourceSysdigSecureAcceptPostureControlUpdate(ctx context.Context, d *sche
 	req.Acceptance.Description = d.Get(SchemaDescriptionKey).(string)
 	req.Acceptance.Reason = d.Get(SchemaReasonKey).(string)
 
-	acceptance, errStatus, err := client.UpdateAcceptancePostureRisk(ctx, req)
+	acceptance, err := client.UpdateAcceptancePostureRisk(ctx, req)
 	if err != nil {
-		return diag.Errorf("Error updating accept risk. ID: %s, error status: %s err: %s", req.AcceptanceID, errStatus, err)
+		return diag.Errorf("Error updating accept risk. ID: %s: %s", req.AcceptanceID, err)
 	}
 	d.SetId(acceptance.AcceptanceID)
 	resourceSysdigSecureAcceptPostureControlRead(ctx, d, meta)
@@ -201,12 +201,13 @@ func resourceSysdigSecureAcceptPostureControlRead(ctx context.Context, d *schema
 	}
 
 	id := d.Id()
-	acceptance, errStatus, err := client.GetAcceptancePostureRisk(ctx, id)
+	acceptance, err := client.GetAcceptancePostureRisk(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	if errStatus != "" {
-		return diag.Errorf("Error reading accept risk. error status: %s", errStatus)
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading accept risk: %s", err)
 	}
 
 	err = d.Set(SchemaControlNameKey, acceptance.Data.ControlName)
@@ -3,9 +3,9 @@ package sysdig
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,11 +14,20 @@ import (
 )
 
 func resourceSysdigSecureVulnerabilityAcceptRisk() *schema.Resource {
+	timeout := 5 * time.Minute
+
 	return &schema.Resource{
 		CreateContext: resourceSysdigSecureVulnerabilityAcceptRiskCreate,
 		ReadContext:   resourceSysdigSecureVulnerabilityAcceptRiskRead,
 		UpdateContext: resourceSysdigSecureVulnerabilityAcceptRiskUpdate,
 		DeleteContext: resourceSysdigSecureVulnerabilityAcceptRiskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cve": {
 				Type:     schema.TypeString,
@@ -133,7 +142,7 @@ func resourceSysdigSecureVulnerabilityAcceptRiskCreate(ctx context.Context, d *s
 		}
 	}
 
-	created, _, err := client.SaveAcceptVulnerabilityRisk(ctx, req)
+	created, err := client.SaveAcceptVulnerabilityRisk(ctx, req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -252,9 +261,9 @@ func resourceSysdigSecureVulnerabilityAcceptRiskRead(ctx context.Context, d *sch
 	}
 
 	id := d.Id()
-	result, statusCode, err := client.GetAcceptanceVulnerabilityRiskByID(ctx, id)
+	result, err := client.GetAcceptanceVulnerabilityRiskByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -344,7 +353,7 @@ func resourceSysdigSecureVulnerabilityAcceptRiskUpdate(ctx context.Context, d *s
 		req.ExpirationDate = expirationDate.(string)
 	}
 
-	_, _, err = client.UpdateAcceptanceVulnerabilityRisk(ctx, req)
+	_, err = client.UpdateAcceptanceVulnerabilityRisk(ctx, req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -143,13 +142,13 @@ func resourceSysdigAWSMLPolicyRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	policy, statusCode, err := client.GetCompositePolicyByID(ctx, id)
+	policy, err := client.GetCompositePolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
-		} else {
-			return diag.FromErr(err)
+			return nil
 		}
+		return diag.FromErr(err)
 	}
 
 	err = awsMLPolicyToResourceData(&policy, d)
@@ -200,7 +199,7 @@ func resourceSysdigSecureAWSMLPolicyImportState(ctx context.Context, d *schema.R
 		return nil, errors.New("policy ID is missing")
 	}
 
-	policy, _, err = client.GetCompositePolicyByID(ctx, policy.Policy.ID)
+	policy, err = client.GetCompositePolicyByID(ctx, policy.Policy.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -218,9 +217,9 @@ func resourceSysdigSecureCloudauthAccountCreate(ctx context.Context, data *schem
 		return diag.FromErr(err)
 	}
 
-	cloudauthAccount, errStatus, err := client.CreateCloudauthAccountSecure(ctx, cloudauthAccountFromResourceData(data))
+	cloudauthAccount, err := client.CreateCloudauthAccountSecure(ctx, cloudauthAccountFromResourceData(data))
 	if err != nil {
-		return diag.Errorf("Error creating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error creating resource: %s", err)
 	}
 
 	data.SetId(cloudauthAccount.Id)
@@ -238,9 +237,13 @@ func resourceSysdigSecureCloudauthAccountRead(ctx context.Context, data *schema.
 		return diag.FromErr(err)
 	}
 
-	cloudauthAccount, errStatus, err := client.GetCloudauthAccountSecureByID(ctx, data.Id())
+	cloudauthAccount, err := client.GetCloudauthAccountSecureByID(ctx, data.Id())
 	if err != nil {
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		if v2.IsNotFound(err) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	err = cloudauthAccountToResourceData(data, cloudauthAccount)
@@ -257,9 +260,9 @@ func resourceSysdigSecureCloudauthAccountUpdate(ctx context.Context, data *schem
 		return diag.FromErr(err)
 	}
 
-	existingCloudAccount, errStatus, err := client.GetCloudauthAccountSecureByID(ctx, data.Id())
+	existingCloudAccount, err := client.GetCloudauthAccountSecureByID(ctx, data.Id())
 	if err != nil {
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	newCloudAccount := cloudauthAccountFromResourceData(data)
@@ -270,9 +273,9 @@ func resourceSysdigSecureCloudauthAccountUpdate(ctx context.Context, data *schem
 		return diag.Errorf("Error updating resource: %s", err)
 	}
 
-	_, errStatus, err = client.UpdateCloudauthAccountSecure(ctx, data.Id(), newCloudAccount)
+	_, err = client.UpdateCloudauthAccountSecure(ctx, data.Id(), newCloudAccount)
 	if err != nil {
-		return diag.Errorf("Error updating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error updating resource: %s", err)
 	}
 
 	return nil
@@ -284,12 +287,12 @@ func resourceSysdigSecureCloudauthAccountDelete(ctx context.Context, data *schem
 		return diag.FromErr(err)
 	}
 
-	errStatus, err := client.DeleteCloudauthAccountSecure(ctx, data.Id())
+	err = client.DeleteCloudauthAccountSecure(ctx, data.Id())
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error deleting resource: %s %s", errStatus, err)
+		return diag.Errorf("Error deleting resource: %s", err)
 	}
 
 	return nil
@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"maps"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -64,9 +63,9 @@ func resourceSysdigSecureCloudauthAccountComponentCreate(ctx context.Context, da
 	}
 
 	accountID := data.Get(SchemaAccountID).(string)
-	cloudauthAccountComponent, errStatus, err := client.CreateCloudauthAccountComponentSecure(ctx, accountID, cloudauthAccountComponentFromResourceData(data))
+	cloudauthAccountComponent, err := client.CreateCloudauthAccountComponentSecure(ctx, accountID, cloudauthAccountComponentFromResourceData(data))
 	if err != nil {
-		return diag.Errorf("Error creating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error creating resource: %s", err)
 	}
 
 	// using tuple 'accountId/componentType/componentInstance' as TF resource identifier
@@ -85,13 +84,14 @@ func resourceSysdigSecureCloudauthAccountComponentRead(ctx context.Context, data
 		return diag.FromErr(err)
 	}
 
-	cloudauthAccountComponent, errStatus, err := client.GetCloudauthAccountComponentSecure(
+	cloudauthAccountComponent, err := client.GetCloudauthAccountComponentSecure(
 		ctx, data.Get(SchemaAccountID).(string), data.Get(SchemaType).(string), data.Get(SchemaInstance).(string))
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
+			data.SetId("")
 			return nil
 		}
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	err = cloudauthAccountComponentToResourceData(data, cloudauthAccountComponent)
@@ -109,13 +109,13 @@ func resourceSysdigSecureCloudauthAccountComponentUpdate(ctx context.Context, da
 	}
 
 	accountID := data.Get(SchemaAccountID).(string)
-	existingCloudAccountComponent, errStatus, err := client.GetCloudauthAccountComponentSecure(
+	existingCloudAccountComponent, err := client.GetCloudauthAccountComponentSecure(
 		ctx, accountID, data.Get(SchemaType).(string), data.Get(SchemaInstance).(string))
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	newCloudAccountComponent := cloudauthAccountComponentFromResourceData(data)
@@ -126,13 +126,13 @@ func resourceSysdigSecureCloudauthAccountComponentUpdate(ctx context.Context, da
 		return diag.Errorf("Error updating resource: %s", err)
 	}
 
-	_, errStatus, err = client.UpdateCloudauthAccountComponentSecure(
+	_, err = client.UpdateCloudauthAccountComponentSecure(
 		ctx, accountID, data.Get(SchemaType).(string), data.Get(SchemaInstance).(string), newCloudAccountComponent)
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error updating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error updating resource: %s", err)
 	}
 
 	return nil
@@ -144,13 +144,13 @@ func resourceSysdigSecureCloudauthAccountComponentDelete(ctx context.Context, da
 		return diag.FromErr(err)
 	}
 
-	errStatus, err := client.DeleteCloudauthAccountComponentSecure(
+	err = client.DeleteCloudauthAccountComponentSecure(
 		ctx, data.Get(SchemaAccountID).(string), data.Get(SchemaType).(string), data.Get(SchemaInstance).(string))
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error deleting resource: %s %s", errStatus, err)
+		return diag.Errorf("Error deleting resource: %s", err)
 	}
 
 	return nil
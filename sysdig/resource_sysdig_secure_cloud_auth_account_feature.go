@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
@@ -77,10 +76,10 @@ func resourceSysdigSecureCloudauthAccountFeatureCreate(ctx context.Context, data
 	}
 
 	accountID := data.Get(SchemaAccountID).(string)
-	cloudauthAccountFeature, errStatus, err := client.CreateOrUpdateCloudauthAccountFeatureSecure(
+	cloudauthAccountFeature, err := client.CreateOrUpdateCloudauthAccountFeatureSecure(
 		ctx, accountID, data.Get(SchemaType).(string), cloudauthAccountFeatureFromResourceData(data))
 	if err != nil {
-		return diag.Errorf("Error creating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error creating resource: %s", err)
 	}
 
 	// using tuple 'accountId/featureType' as TF resource identifier
@@ -99,13 +98,14 @@ func resourceSysdigSecureCloudauthAccountFeatureRead(ctx context.Context, data *
 		return diag.FromErr(err)
 	}
 
-	cloudauthAccountFeature, errStatus, err := client.GetCloudauthAccountFeatureSecure(
+	cloudauthAccountFeature, err := client.GetCloudauthAccountFeatureSecure(
 		ctx, data.Get(SchemaAccountID).(string), data.Get(SchemaType).(string))
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
+			data.SetId("")
 			return nil
 		}
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	err = cloudauthAccountFeatureToResourceData(data, cloudauthAccountFeature)
@@ -123,13 +123,13 @@ func resourceSysdigSecureCloudauthAccountFeatureUpdate(ctx context.Context, data
 	}
 
 	accountID := data.Get(SchemaAccountID).(string)
-	existingCloudAccountFeature, errStatus, err := client.GetCloudauthAccountFeatureSecure(
+	existingCloudAccountFeature, err := client.GetCloudauthAccountFeatureSecure(
 		ctx, accountID, data.Get(SchemaType).(string))
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	newCloudAccountFeature := cloudauthAccountFeatureFromResourceData(data)
@@ -140,13 +140,13 @@ func resourceSysdigSecureCloudauthAccountFeatureUpdate(ctx context.Context, data
 		return diag.Errorf("Error updating resource: %s", err)
 	}
 
-	_, errStatus, err = client.CreateOrUpdateCloudauthAccountFeatureSecure(
+	_, err = client.CreateOrUpdateCloudauthAccountFeatureSecure(
 		ctx, accountID, data.Get(SchemaType).(string), newCloudAccountFeature)
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error updating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error updating resource: %s", err)
 	}
 
 	return nil
@@ -158,13 +158,13 @@ func resourceSysdigSecureCloudauthAccountFeatureDelete(ctx context.Context, data
 		return diag.FromErr(err)
 	}
 
-	errStatus, err := client.DeleteCloudauthAccountFeatureSecure(
+	err = client.DeleteCloudauthAccountFeatureSecure(
 		ctx, data.Get(SchemaAccountID).(string), data.Get(SchemaType).(string))
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error deleting resource: %s %s", errStatus, err)
+		return diag.Errorf("Error deleting resource: %s", err)
 	}
 
 	return nil
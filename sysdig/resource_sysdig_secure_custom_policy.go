@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -189,9 +188,9 @@ func resourceSysdigCustomPolicyRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	policy, statusCode, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -255,7 +254,7 @@ func resourceSysdigSecureCustomPolicyImportState(ctx context.Context, d *schema.
 		return nil, err
 	}
 
-	policy, _, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
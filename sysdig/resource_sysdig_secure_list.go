@@ -112,7 +112,11 @@ func resourceSysdigListRead(ctx context.Context, d *schema.ResourceData, meta an
 	id, _ := strconv.Atoi(d.Id())
 	list, err := client.GetListByID(ctx, id)
 	if err != nil {
-		d.SetId("")
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("name", list.Name)
@@ -112,7 +112,11 @@ func resourceSysdigMacroRead(ctx context.Context, d *schema.ResourceData, meta a
 	id, _ := strconv.Atoi(d.Id())
 	macro, err := client.GetMacroByID(ctx, id)
 	if err != nil {
-		d.SetId("")
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("name", macro.Name)
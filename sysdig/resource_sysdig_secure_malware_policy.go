@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -164,13 +163,13 @@ func resourceSysdigMalwarePolicyRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	policy, statusCode, err := client.GetCompositePolicyByID(ctx, id)
+	policy, err := client.GetCompositePolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
-		} else {
-			return diag.FromErr(err)
+			return nil
 		}
+		return diag.FromErr(err)
 	}
 
 	err = malwarePolicyToResourceData(&policy, d)
@@ -221,7 +220,7 @@ func resourceSysdigSecureMalwarePolicyImportState(ctx context.Context, d *schema
 		return nil, errors.New("policy ID is missing")
 	}
 
-	policy, _, err = client.GetCompositePolicyByID(ctx, policy.Policy.ID)
+	policy, err = client.GetCompositePolicyByID(ctx, policy.Policy.ID)
 	if err != nil {
 		return nil, err
 	}
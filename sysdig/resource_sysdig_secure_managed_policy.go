@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -111,9 +110,9 @@ func resourceSysdigManagedPolicyRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	policy, statusCode, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -136,9 +135,9 @@ func resourceSysdigManagedPolicyDelete(ctx context.Context, d *schema.ResourceDa
 	id, _ := strconv.Atoi(d.Id())
 
 	// Reset everything back to default values for managed policy
-	policy, statusCode, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -174,9 +173,9 @@ func resourceSysdigManagedPolicyUpdate(ctx context.Context, d *schema.ResourceDa
 
 	id, _ := strconv.Atoi(d.Id())
 
-	policy, statusCode, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -196,7 +195,7 @@ func resourceSysdigManagedPolicyUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func getManagedPolicy(ctx context.Context, client v2.PolicyInterface, policyName string, policyType string) (*v2.Policy, error) {
-	policies, _, err := client.GetPolicies(ctx)
+	policies, err := client.GetPolicies(ctx)
 	if err != nil {
 		return nil, err
 	}
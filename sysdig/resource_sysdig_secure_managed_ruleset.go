@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -148,9 +147,9 @@ func resourceSysdigManagedRulesetRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	policy, statusCode, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 		} else {
 			return diag.FromErr(err)
@@ -189,9 +188,9 @@ func resourceSysdigManagedRulesetUpdate(ctx context.Context, d *schema.ResourceD
 
 	id, _ := strconv.Atoi(d.Id())
 
-	policy, statusCode, err := client.GetPolicyByID(ctx, id)
+	policy, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 		} else {
 			return diag.FromErr(err)
@@ -220,7 +219,7 @@ func resourceSysdigSecureManagedRulesetImportState(ctx context.Context, d *schem
 		return nil, err
 	}
 
-	managedRuleset, _, err := client.GetPolicyByID(ctx, id)
+	managedRuleset, err := client.GetPolicyByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +228,7 @@ func resourceSysdigSecureManagedRulesetImportState(ctx context.Context, d *schem
 		return nil, errors.New("unable to import policy that is not a managed ruleset")
 	}
 
-	policies, _, err := client.GetPolicies(ctx)
+	policies, err := client.GetPolicies(ctx)
 	if err != nil {
 		return nil, err
 	}
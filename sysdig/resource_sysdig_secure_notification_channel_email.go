@@ -16,7 +16,7 @@ import (
 func resourceSysdigSecureNotificationChannelEmail() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelEmailCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelEmailUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelEmailRead,
@@ -39,7 +39,7 @@ func resourceSysdigSecureNotificationChannelEmail() *schema.Resource {
 				Required: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelEmailCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -77,7 +77,7 @@ func resourceSysdigSecureNotificationChannelEmailRead(ctx context.Context, d *sc
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
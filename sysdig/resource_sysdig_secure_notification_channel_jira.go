@@ -0,0 +1,197 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSysdigSecureNotificationChannelJira() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return teamScopedResource(&schema.Resource{
+		CreateContext: resourceSysdigSecureNotificationChannelJiraCreate,
+		UpdateContext: resourceSysdigSecureNotificationChannelJiraUpdate,
+		ReadContext:   resourceSysdigSecureNotificationChannelJiraRead,
+		DeleteContext: resourceSysdigSecureNotificationChannelJiraDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"issue_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Task",
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"api_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"assignee": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		}),
+	})
+}
+
+func resourceSysdigSecureNotificationChannelJiraCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getSecureNotificationChannelClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teamID, err := client.CurrentTeamID(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notificationChannel, err := secureNotificationChannelJiraFromResourceData(d, teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notificationChannel, err = client.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(notificationChannel.ID))
+
+	return resourceSysdigSecureNotificationChannelJiraRead(ctx, d, meta)
+}
+
+func resourceSysdigSecureNotificationChannelJiraRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getSecureNotificationChannelClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, _ := strconv.Atoi(d.Id())
+	nc, err := client.GetNotificationChannelByID(ctx, id)
+	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = secureNotificationChannelJiraToResourceData(&nc, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigSecureNotificationChannelJiraUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getSecureNotificationChannelClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teamID, err := client.CurrentTeamID(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nc, err := secureNotificationChannelJiraFromResourceData(d, teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nc.Version = d.Get("version").(int)
+	nc.ID, _ = strconv.Atoi(d.Id())
+
+	_, err = client.UpdateNotificationChannel(ctx, nc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigSecureNotificationChannelJiraDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getSecureNotificationChannelClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, _ := strconv.Atoi(d.Id())
+
+	err = client.DeleteNotificationChannel(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func secureNotificationChannelJiraFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = secureNotificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return nc, err
+	}
+
+	nc.Type = notificationChannelTypeJira
+	nc.Options.URL = d.Get("url").(string)
+	nc.Options.Project = d.Get("project").(string)
+	nc.Options.IssueType = d.Get("issue_type").(string)
+	nc.Options.User = d.Get("user").(string)
+	nc.Options.APIToken = d.Get("api_token").(string)
+	nc.Options.Assignee = d.Get("assignee").(string)
+	for _, label := range d.Get("labels").(*schema.Set).List() {
+		nc.Options.Labels = append(nc.Options.Labels, label.(string))
+	}
+	return nc, err
+}
+
+func secureNotificationChannelJiraToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = secureNotificationChannelToResourceData(nc, d)
+	if err != nil {
+		return err
+	}
+
+	_ = d.Set("url", nc.Options.URL)
+	_ = d.Set("project", nc.Options.Project)
+	_ = d.Set("issue_type", nc.Options.IssueType)
+	_ = d.Set("user", nc.Options.User)
+	_ = d.Set("api_token", nc.Options.APIToken)
+	_ = d.Set("assignee", nc.Options.Assignee)
+	_ = d.Set("labels", nc.Options.Labels)
+
+	return err
+}
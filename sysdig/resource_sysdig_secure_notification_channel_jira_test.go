@@ -0,0 +1,67 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common || tf_acc_onprem_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecureNotificationChannelJira(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelJiraWithName(rText()),
+			},
+			{
+				Config: secureNotificationChannelJiraShareWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_jira.sample-jira",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func secureNotificationChannelJiraWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_jira" "sample-jira" {
+	name = "Example Channel %s - Jira"
+	enabled = true
+	url = "https://example.atlassian.net"
+	project = "OPS"
+	user = "jira@example.com"
+	api_token = "ATATT3xFfGF0"
+	labels = ["sysdig"]
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
+
+func secureNotificationChannelJiraShareWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_jira" "sample-jira" {
+	name = "Example Channel %s - Jira"
+	share_with_current_team = true
+	enabled = true
+	url = "https://example.atlassian.net"
+	project = "OPS"
+	user = "jira@example.com"
+	api_token = "ATATT3xFfGF0"
+	labels = ["sysdig"]
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
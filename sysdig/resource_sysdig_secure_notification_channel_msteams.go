@@ -15,7 +15,7 @@ import (
 func resourceSysdigSecureNotificationChannelMSTeams() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelMSTeamsCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelMSTeamsUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelMSTeamsRead,
@@ -41,7 +41,7 @@ func resourceSysdigSecureNotificationChannelMSTeams() *schema.Resource {
 				Optional: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelMSTeamsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -83,7 +83,7 @@ func resourceSysdigSecureNotificationChannelMSTeamsRead(ctx context.Context, d *
 
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
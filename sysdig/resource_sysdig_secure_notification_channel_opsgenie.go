@@ -16,7 +16,7 @@ import (
 func resourceSysdigSecureNotificationChannelOpsGenie() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelOpsGenieCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelOpsGenieUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelOpsGenieRead,
@@ -44,7 +44,7 @@ func resourceSysdigSecureNotificationChannelOpsGenie() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"US", "EU"}, false),
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelOpsGenieCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -82,7 +82,7 @@ func resourceSysdigSecureNotificationChannelOpsGenieRead(ctx context.Context, d
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
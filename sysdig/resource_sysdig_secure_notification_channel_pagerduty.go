@@ -15,7 +15,7 @@ import (
 func resourceSysdigSecureNotificationChannelPagerduty() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelPagerdutyCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelPagerdutyUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelPagerdutyRead,
@@ -45,7 +45,7 @@ func resourceSysdigSecureNotificationChannelPagerduty() *schema.Resource {
 				Required: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelPagerdutyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -83,7 +83,7 @@ func resourceSysdigSecureNotificationChannelPagerdutyRead(ctx context.Context, d
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
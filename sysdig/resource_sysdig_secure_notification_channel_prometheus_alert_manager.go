@@ -15,7 +15,7 @@ import (
 func resourceSysdigSecureNotificationChannelPrometheusAlertManager() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelPrometheusAlertManagerCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelPrometheusAlertManagerUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelPrometheusAlertManagerRead,
@@ -46,7 +46,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManager() *schema.Res
 				Default:  false,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelPrometheusAlertManagerCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -85,7 +85,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManagerRead(ctx conte
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
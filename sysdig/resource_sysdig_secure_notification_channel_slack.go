@@ -15,7 +15,7 @@ import (
 func resourceSysdigSecureNotificationChannelSlack() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelSlackCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelSlackUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelSlackRead,
@@ -57,7 +57,7 @@ func resourceSysdigSecureNotificationChannelSlack() *schema.Resource {
 				Optional: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelSlackCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -99,7 +99,7 @@ func resourceSysdigSecureNotificationChannelSlackRead(ctx context.Context, d *sc
 
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
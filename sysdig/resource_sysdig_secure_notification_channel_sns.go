@@ -15,7 +15,7 @@ import (
 func resourceSysdigSecureNotificationChannelSNS() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelSNSCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelSNSUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelSNSRead,
@@ -38,7 +38,7 @@ func resourceSysdigSecureNotificationChannelSNS() *schema.Resource {
 				Required: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelSNSCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -76,7 +76,7 @@ func resourceSysdigSecureNotificationChannelSNSRead(ctx context.Context, d *sche
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
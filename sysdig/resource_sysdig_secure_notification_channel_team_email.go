@@ -80,7 +80,7 @@ func resourceSysdigSecureNotificationChannelTeamEmailRead(ctx context.Context, d
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,42 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common || tf_acc_onprem_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecureNotificationChannelTest(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelTest(rText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sysdig_secure_notification_channel_test.sample", "notification_channel_id", "sysdig_secure_notification_channel_email.sample", "id"),
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_test.sample", "status", "SENT"),
+					resource.TestCheckResourceAttrSet("sysdig_secure_notification_channel_test.sample", "tested_at"),
+				),
+			},
+		},
+	})
+}
+
+func secureNotificationChannelTest(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_email" "sample" {
+	name       = "Example Channel %s - Test"
+	recipients = ["foo@localhost.com"]
+}
+
+resource "sysdig_secure_notification_channel_test" "sample" {
+	notification_channel_id = sysdig_secure_notification_channel_email.sample.id
+}`, name)
+}
@@ -14,7 +14,7 @@ import (
 func resourceSysdigSecureNotificationChannelVictorOps() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelVictorOpsCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelVictorOpsUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelVictorOpsRead,
@@ -40,7 +40,7 @@ func resourceSysdigSecureNotificationChannelVictorOps() *schema.Resource {
 				Required: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelVictorOpsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -78,7 +78,7 @@ func resourceSysdigSecureNotificationChannelVictorOpsRead(ctx context.Context, d
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
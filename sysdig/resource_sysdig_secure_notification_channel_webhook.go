@@ -15,7 +15,7 @@ import (
 func resourceSysdigSecureNotificationChannelWebhook() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	return teamScopedResource(&schema.Resource{
 		CreateContext: resourceSysdigSecureNotificationChannelWebhookCreate,
 		UpdateContext: resourceSysdigSecureNotificationChannelWebhookUpdate,
 		ReadContext:   resourceSysdigSecureNotificationChannelWebhookRead,
@@ -50,7 +50,7 @@ func resourceSysdigSecureNotificationChannelWebhook() *schema.Resource {
 				Optional: true,
 			},
 		}),
-	}
+	})
 }
 
 func resourceSysdigSecureNotificationChannelWebhookCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -88,7 +88,7 @@ func resourceSysdigSecureNotificationChannelWebhookRead(ctx context.Context, d *
 	id, _ := strconv.Atoi(d.Id())
 	nc, err := client.GetNotificationChannelByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrNotificationChannelNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
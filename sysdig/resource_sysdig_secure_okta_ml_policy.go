@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -147,13 +146,13 @@ func resourceSysdigOktaMLPolicyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	policy, statusCode, err := client.GetCompositePolicyByID(ctx, id)
+	policy, err := client.GetCompositePolicyByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
-		} else {
-			return diag.FromErr(err)
+			return nil
 		}
+		return diag.FromErr(err)
 	}
 
 	err = oktaMLPolicyToResourceData(&policy, d)
@@ -200,7 +199,7 @@ func resourceSysdigSecureOktaMLPolicyImportState(ctx context.Context, d *schema.
 		return nil, errors.New("policy ID is missing")
 	}
 
-	policy, _, err = client.GetCompositePolicyByID(ctx, policy.Policy.ID)
+	policy, err = client.GetCompositePolicyByID(ctx, policy.Policy.ID)
 	if err != nil {
 		return nil, err
 	}
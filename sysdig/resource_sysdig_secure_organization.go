@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
@@ -99,9 +98,9 @@ func resourceSysdigSecureOrganizationCreate(ctx context.Context, data *schema.Re
 
 	org := secureOrganizationFromResourceData(data)
 
-	orgCreated, errStatus, err := client.CreateOrganizationSecure(ctx, org)
+	orgCreated, err := client.CreateOrganizationSecure(ctx, org)
 	if err != nil {
-		return diag.Errorf("Error creating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error creating resource: %s", err)
 	}
 
 	data.SetId(orgCreated.Id)
@@ -115,12 +114,12 @@ func resourceSysdigSecureOrganizationDelete(ctx context.Context, data *schema.Re
 		return diag.FromErr(err)
 	}
 
-	errStatus, err := client.DeleteOrganizationSecure(ctx, data.Id())
+	err = client.DeleteOrganizationSecure(ctx, data.Id())
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error deleting resource: %s %s", errStatus, err)
+		return diag.Errorf("Error deleting resource: %s", err)
 	}
 
 	return nil
@@ -132,12 +131,13 @@ func resourceSysdigSecureOrganizationRead(ctx context.Context, data *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	org, errStatus, err := client.GetOrganizationSecure(ctx, data.Id())
+	org, err := client.GetOrganizationSecure(ctx, data.Id())
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
+			data.SetId("")
 			return nil
 		}
-		return diag.Errorf("Error reading resource: %s %s", errStatus, err)
+		return diag.Errorf("Error reading resource: %s", err)
 	}
 
 	err = secureOrganizationToResourceData(data, org)
@@ -156,12 +156,12 @@ func resourceSysdigSecureOrganizationUpdate(ctx context.Context, data *schema.Re
 
 	org := secureOrganizationFromResourceData(data)
 
-	_, errStatus, err := client.UpdateOrganizationSecure(ctx, data.Id(), org)
+	_, err = client.UpdateOrganizationSecure(ctx, data.Id(), org)
 	if err != nil {
-		if strings.Contains(errStatus, "404") {
+		if v2.IsNotFound(err) {
 			return nil
 		}
-		return diag.Errorf("Error updating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error updating resource: %s", err)
 	}
 
 	return nil
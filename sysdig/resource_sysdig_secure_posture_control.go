@@ -76,9 +76,9 @@ func resourceSysdigSecurePostureControlCreateOrUpdate(ctx context.Context, d *sc
 		RemediationDetails: getStringValue(d, SchemaResourceRemediationDetailsKey),
 	}
 
-	control, errStatus, err := client.CreateOrUpdatePostureControl(ctx, req)
+	control, err := client.CreateOrUpdatePostureControl(ctx, req)
 	if err != nil {
-		return diag.Errorf("Error saving control. error status: %s err: %s", errStatus, err)
+		return diag.Errorf("Error saving control: %s", err)
 	}
 
 	d.SetId(control.ID)
@@ -99,6 +99,10 @@ func resourceSysdigSecurePostureContorlRead(ctx context.Context, d *schema.Resou
 
 	control, err := client.GetPostureControlByID(ctx, id)
 	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	err = d.Set(SchemaIDKey, control.ID)
@@ -231,9 +231,9 @@ func resourceSysdigSecurePosturePolicyCreateOrUpdate(ctx context.Context, d *sch
 		RequirementGroups:  groups,
 	}
 
-	new, errStatus, err := client.CreateOrUpdatePosturePolicy(ctx, req)
+	new, err := client.CreateOrUpdatePosturePolicy(ctx, req)
 	if err != nil {
-		return diag.Errorf("Error creating new policy with groups. error status: %s err: %s", errStatus, err)
+		return diag.Errorf("Error creating new policy with groups: %s", err)
 	}
 
 	d.SetId(new.ID)
@@ -254,6 +254,10 @@ func resourceSysdigSecurePosturePolicyRead(ctx context.Context, d *schema.Resour
 
 	policy, err := client.GetPosturePolicyByID(ctx, id)
 	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	err = d.Set(SchemaIDKey, policy.ID)
@@ -139,9 +139,9 @@ func resourceCreateOrUpdatePostureZone(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	zone, errStatus, err := zoneClient.CreateOrUpdatePostureZone(ctx, req)
+	zone, err := zoneClient.CreateOrUpdatePostureZone(ctx, req)
 	if err != nil {
-		return diag.Errorf("Error creating resource: %s %s", errStatus, err)
+		return diag.Errorf("Error creating resource: %s", err)
 	}
 
 	d.SetId(zone.ID)
@@ -163,6 +163,10 @@ func resourceSysdigSecurePostureZoneRead(ctx context.Context, d *schema.Resource
 
 	zone, err := client.GetPostureZoneByID(ctx, id)
 	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
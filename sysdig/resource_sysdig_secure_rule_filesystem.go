@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -114,9 +113,9 @@ func resourceSysdigRuleFilesystemRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	rule, statusCode, err := client.GetRuleByID(ctx, id)
+	rule, err := client.GetRuleByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -122,9 +121,9 @@ func resourceSysdigRuleNetworkRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	rule, statusCode, err := client.GetRuleByID(ctx, id)
+	rule, err := client.GetRuleByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
@@ -7,9 +7,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/draios/terraform-provider-sysdig/sysdig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestRuleStatefulAppends(t *testing.T) {
@@ -46,11 +44,7 @@ func runStatefulTest(steps []resource.TestStep, t *testing.T) {
 				t.Fatal("SYSDIG_SECURE_API_TOKEN must be set for acceptance tests")
 			}
 		},
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"sysdig": func() (*schema.Provider, error) {
-				return sysdig.Provider(), nil
-			},
-		},
-		Steps: steps,
+		ProtoV5ProviderFactories: sysdigProviderFactories(),
+		Steps:                    steps,
 	})
 }
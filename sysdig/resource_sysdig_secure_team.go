@@ -3,7 +3,6 @@ package sysdig
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -179,9 +178,9 @@ func resourceSysdigSecureTeamRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	t, statusCode, err := client.GetTeamByID(ctx, id)
+	t, err := client.GetTeamByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
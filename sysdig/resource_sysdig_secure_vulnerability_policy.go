@@ -158,6 +158,10 @@ func resourceSysdigVulnerabilityPolicyRead(ctx context.Context, d *schema.Resour
 
 	scanningPolicy, err := client.GetVulnerabilityPolicyByID(ctx, d.Id())
 	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
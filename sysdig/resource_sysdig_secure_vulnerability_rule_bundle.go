@@ -325,6 +325,10 @@ func resourceSysdigVulnerabilityRuleBundleRead(ctx context.Context, d *schema.Re
 
 	scanningRuleBundle, err := client.GetVulnerabilityRuleBundleByID(ctx, d.Id())
 	if err != nil {
+		if v2.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
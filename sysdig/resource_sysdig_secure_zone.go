@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"regexp"
 	"strconv"
 	"time"
@@ -221,7 +220,7 @@ func createZone(ctx context.Context, d *schema.ResourceData, clientV1 v2.ZoneInt
 		// Some backends do not expose /platform/v2/zones. A 404 on the
 		// collection endpoint means the endpoint itself is missing, so
 		// fall back to the v1 API when possible.
-		if isNotFound(err) {
+		if v2.IsNotFound(err) {
 			if stateHasExpressions(d) {
 				return 0, diag.FromErr(errZoneV2EndpointMissing)
 			}
@@ -242,14 +241,6 @@ var errZoneV2EndpointMissing = errors.New(
 	"the backend does not expose the /platform/v2/zones API, which is required for expression-based scopes; " +
 		"use rules-based scopes instead, or upgrade the backend to a version that exposes the v2 zones endpoint")
 
-func isNotFound(err error) bool {
-	var apiErr *v2.APIError
-	if errors.As(err, &apiErr) {
-		return apiErr.StatusCode == http.StatusNotFound
-	}
-	return false
-}
-
 func categorizeZone(d *schema.ResourceData) (bool, error) {
 	rawScopes := d.Get(SchemaScopeKey)
 	if rawScopes == nil {
@@ -328,7 +319,7 @@ func readZone(ctx context.Context, d *schema.ResourceData, clientV1 v2.ZoneInter
 	if legacyZone {
 		zone, err := clientV1.GetZoneByID(ctx, id)
 		if err != nil {
-			if isNotFound(err) {
+			if v2.IsNotFound(err) {
 				d.SetId("")
 				return nil
 			}
@@ -340,14 +331,14 @@ func readZone(ctx context.Context, d *schema.ResourceData, clientV1 v2.ZoneInter
 
 	zone, err := clientV2.GetZoneV2(ctx, id)
 	if err != nil {
-		if isNotFound(err) {
+		if v2.IsNotFound(err) {
 			// A 404 here is ambiguous: the zone may be gone, or the
 			// backend may not expose /platform/v2/zones at all. Probe
 			// the v1 endpoint to disambiguate before removing the
 			// zone from state.
 			zoneV1, v1Err := clientV1.GetZoneByID(ctx, id)
 			if v1Err != nil {
-				if isNotFound(v1Err) {
+				if v2.IsNotFound(v1Err) {
 					d.SetId("")
 					return nil
 				}
@@ -436,7 +427,7 @@ func updateZone(ctx context.Context, d *schema.ResourceData, clientV1 v2.ZoneInt
 		if _, err := clientV2.UpdateZoneV2(ctx, zone); err != nil {
 			// Fall back to the v1 API when the backend does not
 			// expose /platform/v2/zones.
-			if isNotFound(err) {
+			if v2.IsNotFound(err) {
 				if stateHasExpressions(d) {
 					return diag.FromErr(errZoneV2EndpointMissing)
 				}
@@ -500,7 +491,7 @@ func deleteZone(ctx context.Context, d *schema.ResourceData, clientV1 v2.ZoneInt
 		// does not expose /platform/v2/zones at all. The v1 delete covers
 		// both cases: it deletes the zone on v1-only backends and
 		// tolerates 404 when the zone no longer exists.
-		if isNotFound(err) {
+		if v2.IsNotFound(err) {
 			if v1Err := clientV1.DeleteZone(ctx, id); v1Err != nil {
 				return diag.FromErr(fmt.Errorf("error deleting Sysdig Zone: %w", v1Err))
 			}
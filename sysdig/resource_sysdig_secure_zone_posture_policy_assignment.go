@@ -91,7 +91,7 @@ func resourceSysdigSecureZonePosturePolicyAssignmentRead(ctx context.Context, d
 
 	assignment, err := client.GetZonePolicyAssignment(ctx, zoneID)
 	if err != nil {
-		if isNotFound(err) {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -71,7 +71,7 @@ func resourceSysdigSSOGlobalSettingsRead(ctx context.Context, d *schema.Resource
 
 	settings, err := client.GetSSOGlobalSettings(ctx, product)
 	if err != nil {
-		if err == v2.ErrSSOGlobalSettingsNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -146,7 +146,7 @@ func resourceSysdigSSOGroupMappingRead(ctx context.Context, d *schema.ResourceDa
 
 	gm, err := client.GetSSOGroupMapping(ctx, id)
 	if err != nil {
-		if err == v2.ErrSSOGroupMappingNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
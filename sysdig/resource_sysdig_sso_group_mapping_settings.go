@@ -96,7 +96,7 @@ func resourceSysdigSSOGroupMappingSettingsRead(ctx context.Context, d *schema.Re
 
 	settings, err := client.GetSSOGroupMappingSettings(ctx)
 	if err != nil {
-		if err == v2.ErrSSOGroupMappingSettingsNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
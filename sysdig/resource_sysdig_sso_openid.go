@@ -223,7 +223,7 @@ func resourceSysdigSSOOpenIDRead(ctx context.Context, d *schema.ResourceData, m
 
 	sso, err := client.GetSSOOpenID(ctx, isSystem, id)
 	if err != nil {
-		if err == v2.ErrSSOOpenIDNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -169,7 +169,7 @@ func resourceSysdigSSOSamlRead(ctx context.Context, d *schema.ResourceData, m an
 
 	sso, err := client.GetSSOSaml(ctx, isSystem, id)
 	if err != nil {
-		if err == v2.ErrSSOSamlNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
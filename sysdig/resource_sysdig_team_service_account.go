@@ -79,7 +79,7 @@ func resourceSysdigTeamServiceAccountRead(ctx context.Context, d *schema.Resourc
 
 	teamServiceAccount, err := client.GetTeamServiceAccountByID(ctx, id)
 	if err != nil {
-		if err == v2.ErrTeamServiceAccountNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -81,9 +80,9 @@ func resourceSysdigUserRead(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	id, _ := strconv.Atoi(d.Id())
-	u, statusCode, err := client.GetUserByID(ctx, id)
+	u, err := client.GetUserByID(ctx, id)
 	if err != nil {
-		if statusCode == http.StatusNotFound {
+		if v2.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		} else {
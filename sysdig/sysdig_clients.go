@@ -2,10 +2,17 @@ package sysdig
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 
@@ -16,7 +23,7 @@ type SysdigClients interface {
 	io.Closer
 	GetClientType() ClientType
 	GetSecureEndpoint() (string, error)
-	GetSecureAPIToken() (string, error)
+	GetSecureAPIToken(ctx context.Context) (string, error)
 
 	Configure(context.Context, *schema.ResourceData)
 	AddCleanupHook(func(context.Context, SysdigClients) error)
@@ -52,6 +59,10 @@ type sysdigClients struct {
 
 	cleanupHooks []func(context.Context, SysdigClients) error
 
+	// the transport settings of each product, parsed once by Configure
+	transport       map[string]*transportVariables
+	transportErrors map[string]error
+
 	// v2
 	monitorClientV2  v2.SysdigMonitor
 	secureClientV2   v2.SysdigSecure
@@ -62,14 +73,36 @@ type sysdigClients struct {
 }
 
 type globalVariables struct {
-	apiURL       string
-	insecure     bool
-	extraHeaders map[string]string
+	*transportVariables
+	apiURL                string
+	insecure              bool
+	extraHeaders          map[string]string
+	requestsPerSecond     float64
+	maxConcurrentRequests int
+	disableBodyLogging    bool
+	readCache             bool
+}
+
+type transportVariables struct {
+	rootCAs               *x509.CertPool
+	clientCertificate     *tls.Certificate
+	proxyURL              *url.URL
+	retryMax              int
+	retryWaitMin          time.Duration
+	retryWaitMax          time.Duration
+	retryableStatusCodes  []int
+	idempotentRetriesOnly bool
 }
 
 type sysdigVariables struct {
 	*globalVariables
-	token string
+	token        string
+	tokenFile    string
+	tokenCommand string
+	clientID     string
+	clientSecret string
+	tokenURL     string
+	tokenScope   string
 }
 
 type sysdigSecureVariables struct {
@@ -86,38 +119,50 @@ type ibmVariables struct {
 	sysdigTeamID   *int
 }
 
-func getSysdigMonitorVariables(data *schema.ResourceData) (*sysdigVariables, error) {
-	var ok bool
-	var apiURL, token any
-
-	if apiURL, ok = data.GetOk("sysdig_monitor_url"); !ok {
+func getSysdigMonitorVariables(data *schema.ResourceData, transport *transportVariables) (*sysdigVariables, error) {
+	apiURL, ok := data.GetOk("sysdig_monitor_url")
+	if !ok {
 		return nil, errors.New("missing sysdig monitor URL")
 	}
 
-	if token, ok = data.GetOk("sysdig_monitor_api_token"); !ok {
-		return nil, errors.New("missing sysdig monitor token")
+	vars, err := getSysdigAuthVariables("monitor", data)
+	if err != nil {
+		return nil, err
 	}
 
-	return &sysdigVariables{
-		globalVariables: &globalVariables{
-			apiURL:       apiURL.(string),
-			insecure:     data.Get("sysdig_monitor_insecure_tls").(bool),
-			extraHeaders: getExtraHeaders(data),
-		},
-		token: token.(string),
-	}, nil
+	vars.globalVariables = &globalVariables{
+		transportVariables:    transport,
+		apiURL:                apiURL.(string),
+		insecure:              data.Get("sysdig_monitor_insecure_tls").(bool),
+		extraHeaders:          getExtraHeaders(data),
+		disableBodyLogging:    data.Get("disable_body_logging").(bool),
+		readCache:             data.Get("enable_read_cache").(bool),
+		requestsPerSecond:     data.Get("sysdig_monitor_requests_per_second").(float64),
+		maxConcurrentRequests: data.Get("sysdig_monitor_max_concurrent_requests").(int),
+	}
+	return vars, nil
 }
 
-func getSysdigSecureVariables(data *schema.ResourceData) (*sysdigSecureVariables, error) {
-	var ok bool
-	var apiURL, token any
-
-	if apiURL, ok = data.GetOk("sysdig_secure_url"); !ok {
+func getSysdigSecureVariables(data *schema.ResourceData, transport *transportVariables) (*sysdigSecureVariables, error) {
+	apiURL, ok := data.GetOk("sysdig_secure_url")
+	if !ok {
 		return nil, errors.New("missing sysdig secure URL")
 	}
 
-	if token, ok = data.GetOk("sysdig_secure_api_token"); !ok {
-		return nil, errors.New("missing sysdig secure token")
+	vars, err := getSysdigAuthVariables("secure", data)
+	if err != nil {
+		return nil, err
+	}
+
+	vars.globalVariables = &globalVariables{
+		transportVariables:    transport,
+		apiURL:                apiURL.(string),
+		insecure:              data.Get("sysdig_secure_insecure_tls").(bool),
+		extraHeaders:          getExtraHeaders(data),
+		disableBodyLogging:    data.Get("disable_body_logging").(bool),
+		readCache:             data.Get("enable_read_cache").(bool),
+		requestsPerSecond:     data.Get("sysdig_secure_requests_per_second").(float64),
+		maxConcurrentRequests: data.Get("sysdig_secure_max_concurrent_requests").(int),
 	}
 
 	skipPolicyV2Msg := false
@@ -126,19 +171,44 @@ func getSysdigSecureVariables(data *schema.ResourceData) (*sysdigSecureVariables
 	}
 
 	return &sysdigSecureVariables{
-		sysdigVariables: &sysdigVariables{
-			globalVariables: &globalVariables{
-				apiURL:       apiURL.(string),
-				insecure:     data.Get("sysdig_secure_insecure_tls").(bool),
-				extraHeaders: getExtraHeaders(data),
-			},
-			token: token.(string),
-		},
+		sysdigVariables: vars,
 		skipPolicyV2Msg: skipPolicyV2Msg,
 	}, nil
 }
 
-func getIBMVariables(product string, data *schema.ResourceData) (*ibmVariables, error) {
+// getSysdigAuthVariables reads how the client of a product authenticates: exactly one of
+// a static API token, a token file, a token command or OAuth client credentials.
+func getSysdigAuthVariables(product string, data *schema.ResourceData) (*sysdigVariables, error) {
+	vars := &sysdigVariables{
+		token:        data.Get(fmt.Sprintf("sysdig_%s_api_token", product)).(string),
+		tokenFile:    data.Get(fmt.Sprintf("sysdig_%s_api_token_file", product)).(string),
+		tokenCommand: data.Get(fmt.Sprintf("sysdig_%s_api_token_command", product)).(string),
+		clientID:     data.Get(fmt.Sprintf("sysdig_%s_client_id", product)).(string),
+		clientSecret: data.Get(fmt.Sprintf("sysdig_%s_client_secret", product)).(string),
+		tokenURL:     data.Get(fmt.Sprintf("sysdig_%s_token_url", product)).(string),
+		tokenScope:   data.Get(fmt.Sprintf("sysdig_%s_token_scope", product)).(string),
+	}
+
+	configured := 0
+	for _, value := range []string{vars.token, vars.tokenFile, vars.tokenCommand, vars.clientID} {
+		if value != "" {
+			configured++
+		}
+	}
+
+	switch {
+	case configured == 0:
+		return nil, fmt.Errorf("missing sysdig %s token", product)
+	case configured > 1:
+		return nil, fmt.Errorf("only one of sysdig_%[1]s_api_token, sysdig_%[1]s_api_token_file, sysdig_%[1]s_api_token_command and sysdig_%[1]s_client_id can be set", product)
+	case vars.clientID != "" && (vars.clientSecret == "" || vars.tokenURL == ""):
+		return nil, fmt.Errorf("sysdig_%[1]s_client_secret and sysdig_%[1]s_token_url are required with sysdig_%[1]s_client_id", product)
+	}
+
+	return vars, nil
+}
+
+func getIBMVariables(product string, data *schema.ResourceData, transport *transportVariables) (*ibmVariables, error) {
 	var ok bool
 	var apiURL, iamURL, instanceID, apiKey any
 	var teamID *int
@@ -164,31 +234,131 @@ func getIBMVariables(product string, data *schema.ResourceData) (*ibmVariables,
 		teamID = &tmp
 	}
 
-	return &ibmVariables{
+	vars := &ibmVariables{
 		globalVariables: &globalVariables{
-			apiURL:       apiURL.(string),
-			insecure:     data.Get(fmt.Sprintf("sysdig_%s_insecure_tls", product)).(bool),
-			extraHeaders: getExtraHeaders(data),
+			transportVariables:    transport,
+			apiURL:                apiURL.(string),
+			insecure:              data.Get(fmt.Sprintf("sysdig_%s_insecure_tls", product)).(bool),
+			extraHeaders:          getExtraHeaders(data),
+			disableBodyLogging:    data.Get("disable_body_logging").(bool),
+			readCache:             data.Get("enable_read_cache").(bool),
+			requestsPerSecond:     data.Get(fmt.Sprintf("sysdig_%s_requests_per_second", product)).(float64),
+			maxConcurrentRequests: data.Get(fmt.Sprintf("sysdig_%s_max_concurrent_requests", product)).(int),
 		},
 		iamURL:         iamURL.(string),
 		instanceID:     instanceID.(string),
 		apiKey:         apiKey.(string),
 		sysdigTeamID:   teamID,
 		sysdigTeamName: data.Get(fmt.Sprintf("sysdig_%s_team_name", product)).(string),
-	}, nil
+	}
+	return vars, nil
+}
+
+// getTransportVariables reads the CA bundle, the client certificate and the proxy the
+// client of a product connects with, and the retry policy shared by all the clients.
+// Certificates are given as PEM or as paths to PEM files.
+func getTransportVariables(product string, data *schema.ResourceData) (*transportVariables, error) {
+	var err error
+	vars := &transportVariables{}
+	vars.retryMax = data.Get("max_retries").(int)
+	vars.idempotentRetriesOnly = !data.Get("retry_non_idempotent_requests").(bool)
+	// the bounds which aren't set are left to the client, which adjusts its default to the other one
+	if value := data.Get("retry_wait_min").(string); value != "" {
+		if vars.retryWaitMin, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid retry_wait_min: %w", err)
+		}
+	}
+	if value := data.Get("retry_wait_max").(string); value != "" {
+		if vars.retryWaitMax, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid retry_wait_max: %w", err)
+		}
+	}
+	if vars.retryWaitMin > 0 && vars.retryWaitMax > 0 && vars.retryWaitMin > vars.retryWaitMax {
+		return nil, errors.New("retry_wait_min must not be greater than retry_wait_max")
+	}
+	if codes, ok := data.GetOk("retryable_status_codes"); ok {
+		for _, code := range codes.(*schema.Set).List() {
+			vars.retryableStatusCodes = append(vars.retryableStatusCodes, code.(int))
+		}
+		slices.Sort(vars.retryableStatusCodes)
+	}
+
+	if value := data.Get(fmt.Sprintf("sysdig_%s_ca_certificate", product)).(string); value != "" {
+		bundle, err := readPEM(value)
+		if err != nil {
+			return nil, fmt.Errorf("error reading sysdig_%s_ca_certificate: %w", product, err)
+		}
+		vars.rootCAs = x509.NewCertPool()
+		if !vars.rootCAs.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("sysdig_%s_ca_certificate contains no PEM encoded certificate", product)
+		}
+	}
+
+	certificate := data.Get(fmt.Sprintf("sysdig_%s_client_certificate", product)).(string)
+	key := data.Get(fmt.Sprintf("sysdig_%s_client_key", product)).(string)
+	if (certificate == "") != (key == "") {
+		return nil, fmt.Errorf("sysdig_%[1]s_client_certificate and sysdig_%[1]s_client_key must be set together", product)
+	}
+	if certificate != "" {
+		certificatePEM, err := readPEM(certificate)
+		if err != nil {
+			return nil, fmt.Errorf("error reading sysdig_%s_client_certificate: %w", product, err)
+		}
+		keyPEM, err := readPEM(key)
+		if err != nil {
+			return nil, fmt.Errorf("error reading sysdig_%s_client_key: %w", product, err)
+		}
+		pair, err := tls.X509KeyPair(certificatePEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sysdig_%s client certificate: %w", product, err)
+		}
+		vars.clientCertificate = &pair
+	}
+
+	if value := data.Get(fmt.Sprintf("sysdig_%s_https_proxy", product)).(string); value != "" {
+		proxyURL, err := url.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sysdig_%s_https_proxy: %w", product, err)
+		}
+		vars.proxyURL = proxyURL
+	}
+	return vars, nil
+}
+
+func validateDuration(i any, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(i.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration such as 500ms or 2m, got %q", k, i)}
+	}
+	return nil, nil
 }
 
-func getIBMMonitorVariables(data *schema.ResourceData) (*ibmVariables, error) {
-	return getIBMVariables("monitor", data)
+// readPEM returns the value itself when it is PEM encoded, or the content of the file it points to.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
 }
 
-func getIBMSecureVariables(data *schema.ResourceData) (*ibmVariables, error) {
-	return getIBMVariables("secure", data)
+func getIBMMonitorVariables(data *schema.ResourceData, transport *transportVariables) (*ibmVariables, error) {
+	return getIBMVariables("monitor", data, transport)
 }
 
+func getIBMSecureVariables(data *schema.ResourceData, transport *transportVariables) (*ibmVariables, error) {
+	return getIBMVariables("secure", data, transport)
+}
+
+// Configure keeps the provider configuration the clients are created from, and parses the
+// transport settings of each product once. Their errors are returned when the clients of the
+// product are created, so that the settings of a product which isn't used don't fail the others.
 func (c *sysdigClients) Configure(ctx context.Context, d *schema.ResourceData) {
 	c.ctx = ctx
 	c.d = d
+	c.transport = map[string]*transportVariables{}
+	c.transportErrors = map[string]error{}
+	for _, product := range []string{"monitor", "secure"} {
+		c.transport[product], c.transportErrors[product] = getTransportVariables(product, d)
+	}
 }
 
 func (c *sysdigClients) AddCleanupHook(cleanupHook func(context.Context, SysdigClients) error) {
@@ -214,12 +384,14 @@ func (c *sysdigClients) GetSecureEndpoint() (string, error) {
 	return endpoint, nil
 }
 
-func (c *sysdigClients) GetSecureAPIToken() (string, error) {
-	secureAPIToken := c.d.Get("sysdig_secure_api_token").(string)
-	if secureAPIToken == "" {
-		return "", errors.New("GetSecureApiToken, sysdig secure token not provided")
+// GetSecureAPIToken returns the token the Secure requests are authenticated with, whichever
+// of the static token, token file, token command or client credentials provides it.
+func (c *sysdigClients) GetSecureAPIToken(ctx context.Context) (string, error) {
+	client, err := c.sysdigSecureClientV2()
+	if err != nil {
+		return "", fmt.Errorf("GetSecureApiToken, %w", err)
 	}
-	return secureAPIToken, nil
+	return client.APIToken(ctx)
 }
 
 func (c *sysdigClients) sysdigMonitorClientV2() (v2.SysdigMonitor, error) {
@@ -230,15 +402,32 @@ func (c *sysdigClients) sysdigMonitorClientV2() (v2.SysdigMonitor, error) {
 		return c.monitorClientV2, nil
 	}
 
-	vars, err := getSysdigMonitorVariables(c.d)
+	if err := c.transportErrors["monitor"]; err != nil {
+		return nil, err
+	}
+	vars, err := getSysdigMonitorVariables(c.d, c.transport["monitor"])
 	if err != nil {
 		return nil, err
 	}
 
 	c.monitorClientV2 = v2.NewSysdigMonitor(
 		v2.WithToken(vars.token),
+		v2.WithTokenFile(vars.tokenFile),
+		v2.WithTokenCommand(vars.tokenCommand),
+		v2.WithClientCredentials(vars.tokenURL, vars.clientID, vars.clientSecret, vars.tokenScope),
 		v2.WithURL(vars.apiURL),
 		v2.WithInsecure(vars.insecure),
+		v2.WithRootCAs(vars.rootCAs),
+		v2.WithClientCertificate(vars.clientCertificate),
+		v2.WithProxyURL(vars.proxyURL),
+		v2.WithRetryMax(vars.retryMax),
+		v2.WithRetryWait(vars.retryWaitMin, vars.retryWaitMax),
+		v2.WithRetryableStatusCodes(vars.retryableStatusCodes),
+		v2.WithIdempotentRetriesOnly(vars.idempotentRetriesOnly),
+		v2.WithRequestsPerSecond(vars.requestsPerSecond),
+		v2.WithMaxConcurrentRequests(vars.maxConcurrentRequests),
+		v2.WithDisableBodyLogging(vars.disableBodyLogging),
+		v2.WithReadCache(vars.readCache),
 		v2.WithExtraHeaders(vars.extraHeaders),
 	)
 
@@ -253,15 +442,32 @@ func (c *sysdigClients) sysdigSecureClientV2() (v2.SysdigSecure, error) {
 		return c.secureClientV2, nil
 	}
 
-	vars, err := getSysdigSecureVariables(c.d)
+	if err := c.transportErrors["secure"]; err != nil {
+		return nil, err
+	}
+	vars, err := getSysdigSecureVariables(c.d, c.transport["secure"])
 	if err != nil {
 		return nil, err
 	}
 
 	c.secureClientV2 = v2.NewSysdigSecure(
 		v2.WithToken(vars.token),
+		v2.WithTokenFile(vars.tokenFile),
+		v2.WithTokenCommand(vars.tokenCommand),
+		v2.WithClientCredentials(vars.tokenURL, vars.clientID, vars.clientSecret, vars.tokenScope),
 		v2.WithURL(vars.apiURL),
 		v2.WithInsecure(vars.insecure),
+		v2.WithRootCAs(vars.rootCAs),
+		v2.WithClientCertificate(vars.clientCertificate),
+		v2.WithProxyURL(vars.proxyURL),
+		v2.WithRetryMax(vars.retryMax),
+		v2.WithRetryWait(vars.retryWaitMin, vars.retryWaitMax),
+		v2.WithRetryableStatusCodes(vars.retryableStatusCodes),
+		v2.WithIdempotentRetriesOnly(vars.idempotentRetriesOnly),
+		v2.WithRequestsPerSecond(vars.requestsPerSecond),
+		v2.WithMaxConcurrentRequests(vars.maxConcurrentRequests),
+		v2.WithDisableBodyLogging(vars.disableBodyLogging),
+		v2.WithReadCache(vars.readCache),
 		v2.WithExtraHeaders(vars.extraHeaders),
 		v2.WithSkipPolicyV2Msg(vars.skipPolicyV2Msg),
 	)
@@ -277,7 +483,10 @@ func (c *sysdigClients) ibmMonitorClient() (v2.IBMMonitor, error) {
 		return c.monitorIBMClient, nil
 	}
 
-	vars, err := getIBMMonitorVariables(c.d)
+	if err := c.transportErrors["monitor"]; err != nil {
+		return nil, err
+	}
+	vars, err := getIBMMonitorVariables(c.d, c.transport["monitor"])
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +498,17 @@ func (c *sysdigClients) ibmMonitorClient() (v2.IBMMonitor, error) {
 		v2.WithIBMInstanceID(vars.instanceID),
 		v2.WithIBMAPIKey(vars.apiKey),
 		v2.WithInsecure(vars.insecure),
+		v2.WithRootCAs(vars.rootCAs),
+		v2.WithClientCertificate(vars.clientCertificate),
+		v2.WithProxyURL(vars.proxyURL),
+		v2.WithRetryMax(vars.retryMax),
+		v2.WithRetryWait(vars.retryWaitMin, vars.retryWaitMax),
+		v2.WithRetryableStatusCodes(vars.retryableStatusCodes),
+		v2.WithIdempotentRetriesOnly(vars.idempotentRetriesOnly),
+		v2.WithRequestsPerSecond(vars.requestsPerSecond),
+		v2.WithMaxConcurrentRequests(vars.maxConcurrentRequests),
+		v2.WithDisableBodyLogging(vars.disableBodyLogging),
+		v2.WithReadCache(vars.readCache),
 		v2.WithSysdigTeamID(vars.sysdigTeamID),
 		v2.WithSysdigTeamName(vars.sysdigTeamName),
 	)
@@ -304,7 +524,10 @@ func (c *sysdigClients) ibmSecureClient() (v2.IBMSecure, error) {
 		return c.secureIBMClient, nil
 	}
 
-	vars, err := getIBMSecureVariables(c.d)
+	if err := c.transportErrors["secure"]; err != nil {
+		return nil, err
+	}
+	vars, err := getIBMSecureVariables(c.d, c.transport["secure"])
 	if err != nil {
 		return nil, err
 	}
@@ -316,6 +539,17 @@ func (c *sysdigClients) ibmSecureClient() (v2.IBMSecure, error) {
 		v2.WithIBMInstanceID(vars.instanceID),
 		v2.WithIBMAPIKey(vars.apiKey),
 		v2.WithInsecure(vars.insecure),
+		v2.WithRootCAs(vars.rootCAs),
+		v2.WithClientCertificate(vars.clientCertificate),
+		v2.WithProxyURL(vars.proxyURL),
+		v2.WithRetryMax(vars.retryMax),
+		v2.WithRetryWait(vars.retryWaitMin, vars.retryWaitMax),
+		v2.WithRetryableStatusCodes(vars.retryableStatusCodes),
+		v2.WithIdempotentRetriesOnly(vars.idempotentRetriesOnly),
+		v2.WithRequestsPerSecond(vars.requestsPerSecond),
+		v2.WithMaxConcurrentRequests(vars.maxConcurrentRequests),
+		v2.WithDisableBodyLogging(vars.disableBodyLogging),
+		v2.WithReadCache(vars.readCache),
 		v2.WithSysdigTeamID(vars.sysdigTeamID),
 		v2.WithSysdigTeamName(vars.sysdigTeamName),
 	)
@@ -365,23 +599,33 @@ func (c *sysdigClients) commonClientV2() (v2.Common, error) {
 	return c.commonV2, err
 }
 
+// GetClientType classifies the client from the URL and credential attributes only, the other
+// settings don't change it and are validated when the client is created.
 func (c *sysdigClients) GetClientType() ClientType {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if _, err := getIBMMonitorVariables(c.d); err == nil {
-		return IBMMonitor
+	isSet := func(attribute string) bool {
+		_, ok := c.d.GetOk(attribute)
+		return ok
 	}
-
-	if _, err := getIBMSecureVariables(c.d); err == nil {
-		return IBMSecure
+	isIBM := func(product string) bool {
+		return isSet(fmt.Sprintf("sysdig_%s_url", product)) &&
+			isSet(fmt.Sprintf("ibm_%s_iam_url", product)) &&
+			isSet(fmt.Sprintf("ibm_%s_instance_id", product)) &&
+			isSet(fmt.Sprintf("ibm_%s_api_key", product))
 	}
 
-	if _, err := getSysdigMonitorVariables(c.d); err == nil {
+	switch {
+	case isIBM("monitor"):
+		return IBMMonitor
+	case isIBM("secure"):
+		return IBMSecure
+	case isSet("sysdig_monitor_url") && (isSet("sysdig_monitor_api_token") ||
+		isSet("sysdig_monitor_api_token_file") ||
+		isSet("sysdig_monitor_api_token_command") ||
+		isSet("sysdig_monitor_client_id")):
 		return SysdigMonitor
+	default:
+		return SysdigSecure
 	}
-
-	return SysdigSecure
 }
 
 func getExtraHeaders(d *schema.ResourceData) map[string]string {
@@ -0,0 +1,127 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSysdigClients_GetClientType(t *testing.T) {
+	for name, test := range map[string]struct {
+		config   map[string]any
+		expected ClientType
+	}{
+		"sysdig monitor": {
+			config:   map[string]any{"sysdig_monitor_url": "https://app.sysdigcloud.com", "sysdig_monitor_api_token": "token"},
+			expected: SysdigMonitor,
+		},
+		"sysdig monitor with a token command": {
+			config:   map[string]any{"sysdig_monitor_url": "https://app.sysdigcloud.com", "sysdig_monitor_api_token_command": "vault read token"},
+			expected: SysdigMonitor,
+		},
+		"sysdig monitor with an invalid CA bundle": {
+			config: map[string]any{
+				"sysdig_monitor_url":            "https://app.sysdigcloud.com",
+				"sysdig_monitor_api_token":      "token",
+				"sysdig_monitor_ca_certificate": "/nonexistent/ca.pem",
+			},
+			expected: SysdigMonitor,
+		},
+		"sysdig secure": {
+			config:   map[string]any{"sysdig_secure_url": "https://secure.sysdig.com", "sysdig_secure_api_token": "token"},
+			expected: SysdigSecure,
+		},
+		"ibm monitor": {
+			config: map[string]any{
+				"sysdig_monitor_url":      "https://us-south.monitoring.cloud.ibm.com",
+				"ibm_monitor_iam_url":     "https://iam.cloud.ibm.com",
+				"ibm_monitor_instance_id": "instance",
+				"ibm_monitor_api_key":     "key",
+			},
+			expected: IBMMonitor,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			clients := &sysdigClients{}
+			clients.Configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, test.config))
+			if clientType := clients.GetClientType(); clientType != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, clientType)
+			}
+		})
+	}
+}
+
+func TestSysdigClients_TransportErrors(t *testing.T) {
+	clients := &sysdigClients{}
+	clients.Configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"sysdig_monitor_url":            "https://app.sysdigcloud.com",
+		"sysdig_monitor_api_token":      "token",
+		"sysdig_monitor_ca_certificate": "/nonexistent/ca.pem",
+		"sysdig_secure_url":             "https://secure.sysdig.com",
+		"sysdig_secure_api_token":       "token",
+	}))
+
+	if _, err := clients.sysdigMonitorClientV2(); err == nil {
+		t.Error("expected the invalid CA bundle to fail the Monitor client")
+	}
+	if _, err := clients.sysdigSecureClientV2(); err != nil {
+		t.Errorf("expected the Secure client not to depend on the Monitor CA bundle, got %v", err)
+	}
+}
+
+func TestGetTransportVariables_RetryWait(t *testing.T) {
+	for name, test := range map[string]struct {
+		config                           map[string]any
+		expectedWaitMin, expectedWaitMax time.Duration
+		expectedError                    bool
+	}{
+		"defaults":       {config: map[string]any{}},
+		"only min":       {config: map[string]any{"retry_wait_min": "45s"}, expectedWaitMin: 45 * time.Second},
+		"only max":       {config: map[string]any{"retry_wait_max": "500ms"}, expectedWaitMax: 500 * time.Millisecond},
+		"both":           {config: map[string]any{"retry_wait_min": "2s", "retry_wait_max": "1m"}, expectedWaitMin: 2 * time.Second, expectedWaitMax: time.Minute},
+		"min beyond max": {config: map[string]any{"retry_wait_min": "45s", "retry_wait_max": "30s"}, expectedError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			vars, err := getTransportVariables("monitor", schema.TestResourceDataRaw(t, Provider().Schema, test.config))
+			if test.expectedError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if vars.retryWaitMin != test.expectedWaitMin || vars.retryWaitMax != test.expectedWaitMax {
+				t.Errorf("expected the bounds %s and %s, got %s and %s", test.expectedWaitMin, test.expectedWaitMax, vars.retryWaitMin, vars.retryWaitMax)
+			}
+		})
+	}
+}
+
+func TestSysdigClients_GetSecureAPIToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("rotated-token\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clients := &sysdigClients{}
+	clients.Configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"sysdig_secure_url":            "https://secure.sysdig.com",
+		"sysdig_secure_api_token_file": path,
+	}))
+
+	token, err := clients.GetSecureAPIToken(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "rotated-token" {
+		t.Errorf("expected the token of the token file, got %s", token)
+	}
+}
@@ -0,0 +1,97 @@
+package sysdig
+
+import (
+	"context"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// teamScopedResource lets a resource be managed in another team than the one of the
+// provider configuration: it adds the team_id and team_name attributes and issues every
+// request of the resource in the context of that team.
+func teamScopedResource(r *sdkschema.Resource) *sdkschema.Resource {
+	r.Schema[SchemaTeamIDKey] = &sdkschema.Schema{
+		Type:          sdkschema.TypeInt,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{SchemaTeamNameKey},
+	}
+	r.Schema[SchemaTeamNameKey] = &sdkschema.Schema{
+		Type:          sdkschema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{SchemaTeamIDKey},
+	}
+
+	r.CreateContext = withTeamOverride(r.CreateContext)
+	r.ReadContext = withTeamOverride(r.ReadContext)
+	r.UpdateContext = withTeamOverride(r.UpdateContext)
+	r.DeleteContext = withTeamOverride(r.DeleteContext)
+	return r
+}
+
+func withTeamOverride(f func(context.Context, *sdkschema.ResourceData, any) diag.Diagnostics) func(context.Context, *sdkschema.ResourceData, any) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *sdkschema.ResourceData, meta any) diag.Diagnostics {
+		ctx = v2.WithTeamOverride(ctx, v2.TeamOverride{
+			ID:   d.Get(SchemaTeamIDKey).(int),
+			Name: d.Get(SchemaTeamNameKey).(string),
+		})
+		return f(ctx, d, meta)
+	}
+}
+
+// teamOverrideModel is the plugin framework counterpart of teamScopedResource,
+// embedded in the model of the team scoped resources.
+type teamOverrideModel struct {
+	TeamID   types.Int64  `tfsdk:"team_id"`
+	TeamName types.String `tfsdk:"team_name"`
+}
+
+func (m *teamOverrideModel) teamOverride() v2.TeamOverride {
+	return v2.TeamOverride{
+		ID:   int(m.TeamID.ValueInt64()),
+		Name: m.TeamName.ValueString(),
+	}
+}
+
+// teamScopedModel is implemented by the models embedding teamOverrideModel.
+type teamScopedModel interface {
+	teamOverride() v2.TeamOverride
+}
+
+func teamOverrideAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		SchemaTeamIDKey: schema.Int64Attribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+		},
+		SchemaTeamNameKey: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot(SchemaTeamIDKey)),
+			},
+		},
+	}
+}
+
+// contextWithTeamOverride issues the requests of ctx in the team of the model, if it is team scoped.
+func contextWithTeamOverride(ctx context.Context, model any) context.Context {
+	if m, ok := model.(teamScopedModel); ok {
+		return v2.WithTeamOverride(ctx, m.teamOverride())
+	}
+	return ctx
+}